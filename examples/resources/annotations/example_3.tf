@@ -0,0 +1,10 @@
+resource "kubernetes_annotations" "example" {
+  api_version = "v1"
+  kind        = "ServiceAccount"
+  selector {
+    namespace = "my-namespace"
+  }
+  annotations = {
+    "owner" = "myteam"
+  }
+}
@@ -0,0 +1,15 @@
+resource "kubernetes_container_image" "example" {
+  api_version = "apps/v1"
+  kind        = "Deployment"
+  metadata {
+    name      = "nginx-deployment"
+    namespace = "web"
+  }
+
+  container {
+    name  = "nginx"
+    image = "nginx:1.27.2"
+  }
+
+  wait_for_rollout = true
+}
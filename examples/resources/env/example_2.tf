@@ -0,0 +1,20 @@
+resource "kubernetes_env" "example" {
+  containers = ["*"]
+  metadata {
+    name = "nightly-report"
+  }
+
+  api_version = "batch/v1"
+  kind        = "CronJob"
+
+  env {
+    name  = "LOG_LEVEL"
+    value = "debug"
+  }
+
+  env_from {
+    config_map_ref {
+      name = "report-settings"
+    }
+  }
+}
@@ -0,0 +1,10 @@
+resource "kubernetes_labels" "example" {
+  api_version = "v1"
+  kind        = "Namespace"
+  selector {
+    label_selector = "team=x"
+  }
+  labels = {
+    "istio-injection" = "enabled"
+  }
+}
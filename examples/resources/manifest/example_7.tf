@@ -0,0 +1,13 @@
+resource "kubernetes_manifest" "test" {
+  manifest = {
+    // ...
+  }
+
+  delete_options {
+    # leave the dependents of the object in place when it is destroyed
+    propagation_policy = "Orphan"
+
+    # delete the object without waiting for it to terminate gracefully
+    grace_period_seconds = 0
+  }
+}
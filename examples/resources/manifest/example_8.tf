@@ -0,0 +1,8 @@
+resource "kubernetes_manifest" "test" {
+  manifest = {
+    // ...
+  }
+
+  # keep the object in the cluster when this resource is destroyed
+  destroy_behavior = "abandon"
+}
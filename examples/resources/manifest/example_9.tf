@@ -0,0 +1,9 @@
+resource "kubernetes_manifest" "test" {
+  manifest = {
+    // ...
+  }
+
+  wait {
+    ready = true
+  }
+}
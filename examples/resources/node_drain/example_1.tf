@@ -0,0 +1,12 @@
+resource "kubernetes_node_drain" "example" {
+  node_selector = "node.kubernetes.io/pool=old"
+
+  ignore_daemonsets    = true
+  delete_emptydir_data = true
+  grace_period         = 60
+  uncordon_on_destroy  = true
+
+  timeouts {
+    create = "30m"
+  }
+}
@@ -0,0 +1,14 @@
+resource "kubernetes_patch" "example" {
+  api_version = "v1"
+  kind        = "ServiceAccount"
+  metadata {
+    name      = "default"
+    namespace = "default"
+  }
+  patch = yamlencode({
+    imagePullSecrets = [
+      { name = "registry-credentials" },
+    ]
+  })
+  restore_on_destroy = true
+}
@@ -0,0 +1,10 @@
+resource "kubernetes_scale" "example" {
+  api_version = "apps/v1"
+  kind        = "Deployment"
+  metadata {
+    name      = "my-app"
+    namespace = "default"
+  }
+  replicas          = 3
+  wait_for_replicas = true
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-provider-kubernetes/kubernetes"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
 
 	authv1 "k8s.io/api/authentication/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -163,7 +164,16 @@ func (r *TokenRequestEphemeralResource) Open(ctx context.Context, req ephemeral.
 	name := data.Metadata.Name.ValueString()
 	namespace := data.Metadata.Namespace.ValueString()
 	if namespace == "" {
-		namespace = "default"
+		namespace = kubernetes.DefaultNamespace(r.SDKv2Meta())
+	}
+
+	protection := kubernetes.ProtectionConfig(r.SDKv2Meta())
+	if err := protection.Check(util.OperationCreate, "TokenRequest", namespace, name); err != nil {
+		if !protection.Warn() {
+			resp.Diagnostics.AddError("Protected object", err.Error())
+			return
+		}
+		resp.Diagnostics.AddWarning("Protected object", err.Error())
 	}
 
 	conn, err := r.SDKv2Meta().(kubernetes.KubeClientsets).MainClientset()
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-provider-kubernetes/kubernetes"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
 
 	certificatesv1 "k8s.io/api/certificates/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -141,6 +142,19 @@ func (r *CertificateSigningRequestEphemeralResource) Open(ctx context.Context, r
 	}
 
 	name := data.Metadata.Name.ValueString()
+
+	if kubernetes.IsReadOnly(r.SDKv2Meta()) {
+		resp.Diagnostics.AddError("Provider is read-only", util.ReadOnlyError(util.OperationCreate, "CertificateSigningRequest", "", name).Error())
+		return
+	}
+	protection := kubernetes.ProtectionConfig(r.SDKv2Meta())
+	if err := protection.Check(util.OperationCreate, "CertificateSigningRequest", "", name); err != nil {
+		if !protection.Warn() {
+			resp.Diagnostics.AddError("Protected object", err.Error())
+			return
+		}
+		resp.Diagnostics.AddWarning("Protected object", err.Error())
+	}
 	conn, err := r.SDKv2Meta().(kubernetes.KubeClientsets).MainClientset()
 	if err != nil {
 		resp.Diagnostics.AddError("error setting up kubernetes client", err.Error())
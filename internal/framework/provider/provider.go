@@ -62,6 +62,13 @@ type KubernetesProviderModel struct {
 	IgnoreAnnotations types.List `tfsdk:"ignore_annotations"`
 	IgnoreLabels      types.List `tfsdk:"ignore_labels"`
 
+	Namespace types.String `tfsdk:"namespace"`
+
+	DefaultLabels      types.Map `tfsdk:"default_labels"`
+	DefaultAnnotations types.Map `tfsdk:"default_annotations"`
+
+	StartupTimeout types.String `tfsdk:"startup_timeout"`
+
 	Exec []struct {
 		APIVersion types.String            `tfsdk:"api_version"`
 		Command    types.String            `tfsdk:"command"`
@@ -69,6 +76,24 @@ type KubernetesProviderModel struct {
 		Args       []types.String          `tfsdk:"args"`
 	} `tfsdk:"exec"`
 
+	DeleteOptions []struct {
+		PropagationPolicy  types.String `tfsdk:"propagation_policy"`
+		GracePeriodSeconds types.String `tfsdk:"grace_period_seconds"`
+	} `tfsdk:"delete_options"`
+
+	ReadOnly types.Bool `tfsdk:"read_only"`
+
+	ProtectedNamespaces types.List   `tfsdk:"protected_namespaces"`
+	ProtectedKinds      types.List   `tfsdk:"protected_kinds"`
+	ProtectionMode      types.String `tfsdk:"protection_mode"`
+
+	ServerDryRun types.Bool `tfsdk:"server_dry_run"`
+
+	ServerSideApply []struct {
+		FieldManager   types.String `tfsdk:"field_manager"`
+		ForceConflicts types.Bool   `tfsdk:"force_conflicts"`
+	} `tfsdk:"server_side_apply"`
+
 	Experiments []struct {
 		ManifestResource types.Bool `tfsdk:"manifest_resource"`
 	} `tfsdk:"experiments"`
@@ -153,6 +178,46 @@ func (p *KubernetesProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				Description: "List of Kubernetes metadata labels to ignore across all resources handled by this provider for situations where external systems are managing certain resource labels. Each item is a regular expression.",
 				Optional:    true,
 			},
+			"namespace": schema.StringAttribute{
+				Description: "Namespace used by namespaced resources and data sources that do not set one. Defaults to the namespace of the selected kubeconfig context, or `default`.",
+				Optional:    true,
+			},
+			"default_labels": schema.MapAttribute{
+				ElementType: types.StringType,
+				Description: "Labels added to the metadata of every resource handled by this provider. Labels set on a resource take precedence.",
+				Optional:    true,
+			},
+			"default_annotations": schema.MapAttribute{
+				ElementType: types.StringType,
+				Description: "Annotations added to the metadata of every resource handled by this provider. Annotations set on a resource take precedence.",
+				Optional:    true,
+			},
+			"startup_timeout": schema.StringAttribute{
+				Description: "Maximum time to wait for the Kubernetes API server to report ready on its `/readyz` endpoint when the provider is configured, e.g. `5m`. Useful when the cluster is created in the same run. By default the provider does not wait.",
+				Optional:    true,
+			},
+			"read_only": schema.BoolAttribute{
+				Description: "Refuse every create, update and delete operation before it reaches the API server. Reads, data sources and plan-time dry-runs keep working.",
+				Optional:    true,
+			},
+			"protected_namespaces": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "List of namespaces in which objects must not be created, updated or deleted, e.g. `kube-system`. Each item is a namespace name or a glob pattern.",
+				Optional:    true,
+			},
+			"protected_kinds": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "List of object kinds that must not be created, updated or deleted, e.g. `CustomResourceDefinition`.",
+				Optional:    true,
+			},
+			"protection_mode": schema.StringAttribute{
+				Description: "What to do with operations on objects matched by `protected_namespaces` or `protected_kinds`: `deny` refuses them, `warn` only warns about them. Defaults to `deny`.",
+				Optional:    true,
+			},
+			"server_dry_run": schema.BoolAttribute{
+				Description: "Validate typed resources with a server-side dry-run of the planned object when planning, so that objects rejected by the API server or by admission webhooks fail the plan.",
+				Optional:    true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"exec": schema.ListNestedBlock{
@@ -175,6 +240,36 @@ func (p *KubernetesProvider) Schema(ctx context.Context, req provider.SchemaRequ
 					},
 				},
 			},
+			"delete_options": schema.ListNestedBlock{
+				Description: "Default options used when deleting resources. Can be overridden with the `delete_options` block of each resource.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"propagation_policy": schema.StringAttribute{
+							Description: "Whether and how garbage collection is performed on the dependents of the deleted object. One of `Orphan`, `Background` or `Foreground`.",
+							Optional:    true,
+						},
+						"grace_period_seconds": schema.StringAttribute{
+							Description: "The duration in seconds before the object should be deleted. Zero means delete immediately.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"server_side_apply": schema.ListNestedBlock{
+				Description: "Enable server-side apply for the typed resources that support it. Objects are applied with an apply patch instead of being created and updated.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"field_manager": schema.StringAttribute{
+							Description: "The name of the field manager used to apply resources. Defaults to `Terraform`.",
+							Optional:    true,
+						},
+						"force_conflicts": schema.BoolAttribute{
+							Description: "Force changes against conflicts with other field managers.",
+							Optional:    true,
+						},
+					},
+				},
+			},
 			"experiments": schema.ListNestedBlock{
 				Description: "Enable and disable experimental features.",
 				NestedObject: schema.NestedBlockObject{
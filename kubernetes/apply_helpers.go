@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/csaupgrade"
+	"k8s.io/utils/ptr"
+)
+
+// legacyFieldManagerName is the field manager the API server derives from the
+// user agent of the provider for the objects it writes without server-side apply.
+const legacyFieldManagerName = "HashiCorp"
+
+// serverSideApplyConfig holds the provider level `server_side_apply` settings.
+type serverSideApplyConfig struct {
+	FieldManager   string
+	ForceConflicts bool
+}
+
+func serverSideApplyFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"field_manager": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of the field manager used to apply resources. Defaults to `Terraform`.",
+		},
+		"force_conflicts": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Force changes against conflicts with other field managers.",
+		},
+	}
+}
+
+func expandServerSideApplyConfig(in []interface{}) *serverSideApplyConfig {
+	if len(in) == 0 {
+		return nil
+	}
+	c := &serverSideApplyConfig{FieldManager: defaultFieldManagerName}
+	m, ok := in[0].(map[string]interface{})
+	if !ok {
+		return c
+	}
+	if v, ok := m["field_manager"].(string); ok && v != "" {
+		c.FieldManager = v
+	}
+	if v, ok := m["force_conflicts"].(bool); ok {
+		c.ForceConflicts = v
+	}
+	return c
+}
+
+func serverSideApplyEnabled(meta interface{}) bool {
+	pm, ok := meta.(providerMetadata)
+	return ok && pm.ServerSideApply != nil
+}
+
+// fieldManagerName returns the name of the field manager used for server-side apply.
+func fieldManagerName(meta interface{}) string {
+	if pm, ok := meta.(providerMetadata); ok && pm.ServerSideApply != nil {
+		return pm.ServerSideApply.FieldManager
+	}
+	return defaultFieldManagerName
+}
+
+// createOrApply creates obj, or server-side applies it when the provider is
+// configured with a `server_side_apply` block. Objects using `generate_name`
+// are always created since an apply requires the name of the object. An apply
+// fails like a create when the object already exists, rather than taking over
+// an object that Terraform does not manage.
+func createOrApply[T runtime.Object](ctx context.Context, meta interface{}, apiVersion, kind string, obj T, create func(context.Context, T, metav1.CreateOptions) (T, error)) (T, error) {
+	var out T
+	pm, ok := meta.(providerMetadata)
+	if !ok || pm.ServerSideApply == nil {
+		return create(ctx, obj, metav1.CreateOptions{})
+	}
+	accessor, err := apimeta.Accessor(obj)
+	if err != nil {
+		return out, err
+	}
+	if accessor.GetName() == "" {
+		return create(ctx, obj, metav1.CreateOptions{})
+	}
+
+	// an apply would update an existing object, which a dry-run of the create tells
+	if _, err := create(ctx, obj, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}}); apierrors.IsAlreadyExists(err) {
+		return out, err
+	}
+
+	log.Printf("[INFO] Applying %s %q with field manager %q", kind, accessor.GetName(), pm.ServerSideApply.FieldManager)
+	res, err := applyObject(ctx, meta, apiVersion, kind, obj, metav1.PatchOptions{
+		FieldManager: pm.ServerSideApply.FieldManager,
+		Force:        &pm.ServerSideApply.ForceConflicts,
+	})
+	if err != nil {
+		return out, err
+	}
+
+	out = reflect.New(reflect.TypeOf(obj).Elem()).Interface().(T)
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(res.Object, out)
+	return out, err
+}
+
+// applyUpdate updates obj with server-side apply. The fields of computed, keyed
+// by the path of their Optional and Computed attribute, are left out of the
+// patch when the attribute is not set in the configuration, so that they are
+// left to the controllers that set them, such as the replicas of a deployment
+// scaled by a HorizontalPodAutoscaler.
+func applyUpdate[T runtime.Object](ctx context.Context, d *schema.ResourceData, meta interface{}, apiVersion, kind string, obj T, computed map[string][]string) (T, error) {
+	var out T
+	accessor, err := apimeta.Accessor(obj)
+	if err != nil {
+		return out, err
+	}
+	omit := omittedFields(d.GetRawConfig(), computed)
+
+	log.Printf("[INFO] Applying %s %q with field manager %q", kind, accessor.GetName(), fieldManagerName(meta))
+	pm := meta.(providerMetadata)
+	res, err := applyObject(ctx, meta, apiVersion, kind, obj, metav1.PatchOptions{
+		FieldManager: pm.ServerSideApply.FieldManager,
+		Force:        &pm.ServerSideApply.ForceConflicts,
+	}, omit...)
+	if err != nil {
+		return out, err
+	}
+
+	out = reflect.New(reflect.TypeOf(obj).Elem()).Interface().(T)
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(res.Object, out)
+	return out, err
+}
+
+// omittedFields returns the paths of the fields of computed whose attribute is
+// not set in the raw configuration config.
+func omittedFields(config cty.Value, computed map[string][]string) [][]string {
+	var omit [][]string
+	for attribute, path := range computed {
+		if !attributeConfigured(config, attribute) {
+			omit = append(omit, path)
+		}
+	}
+	return omit
+}
+
+// attributeConfigured reports whether the attribute at path, like
+// "spec.0.replicas", is set in the raw configuration config.
+func attributeConfigured(config cty.Value, path string) bool {
+	v := config
+	for _, step := range strings.Split(path, ".") {
+		if v.IsNull() || !v.IsKnown() {
+			return !v.IsNull()
+		}
+		switch {
+		case v.Type().IsObjectType():
+			if !v.Type().HasAttribute(step) {
+				return false
+			}
+			v = v.GetAttr(step)
+		case v.CanIterateElements():
+			i, err := strconv.Atoi(step)
+			if err != nil || i >= v.LengthInt() {
+				return false
+			}
+			v = v.AsValueSlice()[i]
+		default:
+			return false
+		}
+	}
+	return !v.IsNull()
+}
+
+func applyObject(ctx context.Context, meta interface{}, apiVersion, kind string, obj runtime.Object, opts metav1.PatchOptions, omit ...[]string) (*unstructured.Unstructured, error) {
+	accessor, err := apimeta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	data, err := applyConfiguration(obj, apiVersion, kind, omit...)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := dynamicResourceInterface(meta, apiVersion, kind, accessor.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	res, err := rs.Patch(ctx, accessor.GetName(), types.ApplyPatchType, data, opts)
+	if err != nil && apierrors.IsConflict(err) {
+		// the fields may be owned by updates made before server-side apply was enabled
+		if upgraded, uerr := upgradeLegacyFieldManager(ctx, rs, accessor.GetName(), &opts); uerr != nil {
+			log.Printf("[WARN] Could not move the fields of %s %q to field manager %q: %s", kind, accessor.GetName(), opts.FieldManager, uerr)
+		} else if upgraded {
+			res, err = rs.Patch(ctx, accessor.GetName(), types.ApplyPatchType, data, opts)
+		}
+	}
+	if err != nil && apierrors.IsConflict(err) {
+		return nil, fmt.Errorf("field manager conflict: %w. Set `force_conflicts = true` in the provider `server_side_apply` block to take ownership of these fields", err)
+	}
+	return res, err
+}
+
+// upgradeLegacyFieldManager moves the fields of an object written by the
+// provider without server-side apply to the field manager of opts, like
+// `kubectl apply --server-side` does for objects created by a client-side
+// apply, and reports whether there were any. For a dry-run the object is left
+// as it is and opts is changed to force the conflicts instead.
+func upgradeLegacyFieldManager(ctx context.Context, rs dynamic.ResourceInterface, name string, opts *metav1.PatchOptions) (bool, error) {
+	live, err := rs.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	patch, err := csaupgrade.UpgradeManagedFieldsPatch(live, sets.New(legacyFieldManagerName), opts.FieldManager)
+	if err != nil || patch == nil {
+		return false, err
+	}
+	if len(opts.DryRun) > 0 {
+		opts.Force = ptr.To(true)
+		return true, nil
+	}
+	log.Printf("[INFO] Moving the fields of %q owned by %q to field manager %q", name, legacyFieldManagerName, opts.FieldManager)
+	if _, err := rs.Patch(ctx, name, types.JSONPatchType, patch, metav1.PatchOptions{}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// applyConfiguration builds the apply patch of a typed object, leaving out its
+// status, the fields set by the API server and the fields at the paths of omit.
+func applyConfiguration(obj runtime.Object, apiVersion, kind string, omit ...[]string) ([]byte, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	u["apiVersion"] = apiVersion
+	u["kind"] = kind
+	delete(u, "status")
+	unstructured.RemoveNestedField(u, "metadata", "creationTimestamp")
+	for _, path := range omit {
+		unstructured.RemoveNestedField(u, path...)
+	}
+	return json.Marshal(u)
+}
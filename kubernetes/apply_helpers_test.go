@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func TestExpandServerSideApplyConfig(t *testing.T) {
+	cases := []struct {
+		name     string
+		in       []interface{}
+		expected *serverSideApplyConfig
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name:     "empty block",
+			in:       []interface{}{nil},
+			expected: &serverSideApplyConfig{FieldManager: defaultFieldManagerName},
+		},
+		{
+			name: "custom field manager",
+			in: []interface{}{
+				map[string]interface{}{
+					"field_manager":   "platform",
+					"force_conflicts": true,
+				},
+			},
+			expected: &serverSideApplyConfig{FieldManager: "platform", ForceConflicts: true},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.expected, expandServerSideApplyConfig(tc.in)); diff != "" {
+				t.Errorf("unexpected config (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestApplyConfiguration(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Data: map[string]string{"key": "value"},
+	}
+	data, err := applyConfiguration(cm, "v1", "ConfigMap")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]interface{}{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":      "test",
+			"namespace": "default",
+		},
+		"data": map[string]interface{}{"key": "value"},
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected apply configuration (-want +got):\n%s", diff)
+	}
+}
+
+func TestApplyConfigurationOmit(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(3)), MinReadySeconds: 5},
+	}
+	data, err := applyConfiguration(deployment, "apps/v1", "Deployment", []string{"spec", "replicas"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]interface{}{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	spec := got["spec"].(map[string]interface{})
+	if _, ok := spec["replicas"]; ok {
+		t.Error("expected the replicas to be left out")
+	}
+	if spec["minReadySeconds"] != float64(5) {
+		t.Errorf("expected the other fields to be kept, got %v", spec)
+	}
+}
+
+func TestAttributeConfigured(t *testing.T) {
+	config := cty.ObjectVal(map[string]cty.Value{
+		"spec": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"replicas":               cty.NullVal(cty.String),
+				"revision_history_limit": cty.NumberIntVal(3),
+			}),
+		}),
+		"wait_for_rollout": cty.UnknownVal(cty.Bool),
+	})
+	cases := map[string]bool{
+		"spec.0.replicas":               false,
+		"spec.0.revision_history_limit": true,
+		"spec.1.replicas":               false,
+		"spec.0.missing":                false,
+		"wait_for_rollout":              true,
+	}
+	for path, expected := range cases {
+		if got := attributeConfigured(config, path); got != expected {
+			t.Errorf("%s: expected %t, got %t", path, expected, got)
+		}
+	}
+	if attributeConfigured(cty.NilVal, "spec.0.replicas") {
+		t.Error("expected nothing to be configured without a configuration")
+	}
+}
+
+func TestUpgradeLegacyFieldManager(t *testing.T) {
+	cm := &unstructured.Unstructured{}
+	cm.SetAPIVersion("v1")
+	cm.SetKind("ConfigMap")
+	cm.SetName("test")
+	cm.SetNamespace("default")
+	cm.SetManagedFields([]metav1.ManagedFieldsEntry{{
+		Manager:    legacyFieldManagerName,
+		Operation:  metav1.ManagedFieldsOperationUpdate,
+		APIVersion: "v1",
+		FieldsType: "FieldsV1",
+		FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:key":{}}}`)},
+	}})
+	gvr := k8sschema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), cm)
+	rs := client.Resource(gvr).Namespace("default")
+
+	opts := metav1.PatchOptions{FieldManager: "Terraform", DryRun: []string{metav1.DryRunAll}}
+	upgraded, err := upgradeLegacyFieldManager(context.Background(), rs, "test", &opts)
+	if err != nil || !upgraded || opts.Force == nil || !*opts.Force {
+		t.Fatalf("expected a dry-run to force the conflicts, got %v, %v, %v", upgraded, opts.Force, err)
+	}
+
+	opts = metav1.PatchOptions{FieldManager: "Terraform"}
+	upgraded, err = upgradeLegacyFieldManager(context.Background(), rs, "test", &opts)
+	if err != nil || !upgraded {
+		t.Fatalf("expected the fields to be upgraded, got %v, %v", upgraded, err)
+	}
+	live, err := rs.Get(context.Background(), "test", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	managers := live.GetManagedFields()
+	if len(managers) != 1 || managers[0].Manager != "Terraform" || managers[0].Operation != metav1.ManagedFieldsOperationApply {
+		t.Fatalf("expected the fields to be owned by the apply of Terraform, got %v", managers)
+	}
+
+	upgraded, err = upgradeLegacyFieldManager(context.Background(), rs, "test", &opts)
+	if err != nil || upgraded {
+		t.Fatalf("expected nothing left to upgrade, got %v, %v", upgraded, err)
+	}
+}
+
+func TestCreateOrApplyExisting(t *testing.T) {
+	existing := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+	conn := fake.NewSimpleClientset(existing)
+	meta := providerMetadata{ServerSideApply: &serverSideApplyConfig{FieldManager: defaultFieldManagerName}}
+
+	obj := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Data:       map[string]string{"key": "value"},
+	}
+	_, err := createOrApply(context.Background(), meta, "v1", "ConfigMap", obj, conn.CoreV1().ConfigMaps("default").Create)
+	if !apierrors.IsAlreadyExists(err) {
+		t.Fatalf("expected an AlreadyExists error, got %v", err)
+	}
+}
@@ -36,7 +36,7 @@ func dataSourceKubernetesEndpointsV1Read(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithNamespace(d.Get("metadata").([]interface{}), meta)
 
 	om := metav1.ObjectMeta{
 		Namespace: metadata.Namespace,
@@ -142,7 +142,7 @@ func dataSourceKubernetesIngressRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithNamespace(d.Get("metadata").([]interface{}), meta)
 
 	om := metav1.ObjectMeta{
 		Namespace: metadata.Namespace,
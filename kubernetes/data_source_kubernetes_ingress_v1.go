@@ -153,7 +153,7 @@ func dataSourceKubernetesIngressV1Read(ctx context.Context, d *schema.ResourceDa
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithNamespace(d.Get("metadata").([]interface{}), meta)
 
 	om := metav1.ObjectMeta{
 		Namespace: metadata.Namespace,
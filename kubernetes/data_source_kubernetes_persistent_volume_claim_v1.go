@@ -96,7 +96,7 @@ func dataSourceKubernetesPersistentVolumeClaimV1Read(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithNamespace(d.Get("metadata").([]interface{}), meta)
 
 	om := metav1.ObjectMeta{
 		Namespace: metadata.Namespace,
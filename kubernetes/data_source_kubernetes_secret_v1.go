@@ -52,7 +52,7 @@ func dataSourceKubernetesSecretV1Read(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithNamespace(d.Get("metadata").([]interface{}), meta)
 
 	om := metav1.ObjectMeta{
 		Namespace: metadata.Namespace,
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// noDefaultMetadataResources are the resources with metadata labels that do not
+// add the provider default labels and annotations to the objects they manage.
+var noDefaultMetadataResources = map[string]bool{
+	"kubernetes_default_service_account":    true,
+	"kubernetes_default_service_account_v1": true,
+	"kubernetes_token_request_v1":           true,
+}
+
+// withDefaultMetadata adds the computed labels_all and annotations_all
+// attributes to a resource, which hold the labels and annotations of the object
+// merged with the provider defaults, like tags_all in other providers. The plan
+// compares them with the configuration, so that a default added to the provider
+// configuration is applied to the objects that already exist.
+func withDefaultMetadata(name string, r *schema.Resource) *schema.Resource {
+	if noDefaultMetadataResources[name] || r.UpdateContext == nil || !hasMetadataLabels(r) {
+		return r
+	}
+	r.Schema["labels_all"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Description: "Map of the labels of the object, including the provider `default_labels`.",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+	r.Schema["annotations_all"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Description: "Map of the annotations of the object, including the provider `default_annotations`.",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, diff, meta); err != nil {
+				return err
+			}
+		}
+		// the attributes are read from the object once it is created
+		if diff.Id() == "" {
+			return nil
+		}
+		var defaultAnnotations, defaultLabels map[string]string
+		if pm, ok := meta.(providerMetadata); ok {
+			defaultAnnotations = pm.DefaultAnnotations
+			defaultLabels = pm.DefaultLabels
+		}
+		if err := diffDefaultMetadata(diff, "annotations", defaultAnnotations); err != nil {
+			return err
+		}
+		return diffDefaultMetadata(diff, "labels", defaultLabels)
+	}
+	return r
+}
+
+// diffDefaultMetadata plans the labels or annotations of the object, given by
+// key, as the configured ones merged with the provider defaults.
+func diffDefaultMetadata(diff *schema.ResourceDiff, key string, defaults map[string]string) error {
+	if !diff.NewValueKnown("metadata.0." + key) {
+		return diff.SetNewComputed(key + "_all")
+	}
+	expected := withDefaultKeys(defaults, diff.Get("metadata.0."+key).(map[string]interface{}))
+	actual := diff.Get(key + "_all").(map[string]interface{})
+	if len(expected) == 0 && len(actual) == 0 || reflect.DeepEqual(expected, actual) {
+		return nil
+	}
+	return diff.SetNew(key+"_all", expected)
+}
+
+// setDefaultMetadata saves the labels or annotations of the object, given by
+// key, to the computed attribute added by withDefaultMetadata, if the resource has one.
+func setDefaultMetadata(d *schema.ResourceData, key string, m map[string]string) {
+	if !hasAttribute(d, key+"_all") {
+		return
+	}
+	all := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		all[k] = v
+	}
+	d.Set(key+"_all", all)
+}
+
+func hasMetadataLabels(r *schema.Resource) bool {
+	m, ok := r.Schema["metadata"]
+	if !ok || m.ForceNew {
+		return false
+	}
+	elem, ok := m.Elem.(*schema.Resource)
+	if !ok {
+		return false
+	}
+	labels, ok := elem.Schema["labels"]
+	return ok && !labels.ForceNew
+}
+
+// hasAttribute reports whether the schema of d has the top-level attribute key.
+func hasAttribute(d *schema.ResourceData, key string) bool {
+	return d.GetRawState().Type().HasAttribute(key)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDefaultMetadataAddedToExistingResource(t *testing.T) {
+	r := withDefaultMetadata("kubernetes_config_map_v1", resourceKubernetesConfigMapV1())
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"metadata": []interface{}{map[string]interface{}{
+			"name":   "app",
+			"labels": map[string]interface{}{"app": "web"},
+		}},
+	})
+	state := func(labelsAll map[string]string) *terraform.InstanceState {
+		attributes := map[string]string{
+			"id":                       "default/app",
+			"immutable":                "false",
+			"metadata.#":               "1",
+			"metadata.0.name":          "app",
+			"metadata.0.namespace":     "default",
+			"metadata.0.annotations.%": "0",
+			"metadata.0.labels.%":      "1",
+			"metadata.0.labels.app":    "web",
+			"annotations_all.%":        "0",
+			"labels_all.%":             strconv.Itoa(len(labelsAll)),
+		}
+		for k, v := range labelsAll {
+			attributes["labels_all."+k] = v
+		}
+		return &terraform.InstanceState{ID: "default/app", Attributes: attributes}
+	}
+	meta := providerMetadata{DefaultLabels: map[string]string{"team": "platform"}}
+
+	// the object was created before the default was added to the provider
+	s := state(map[string]string{"app": "web"})
+	diff, err := r.Diff(context.Background(), s, config, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil || diff.Attributes["labels_all.team"] == nil || diff.Attributes["labels_all.team"].New != "platform" {
+		t.Fatalf("expected the default label to be planned, got %#v", diff)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(s, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
+	expected := PatchOperations{&AddOperation{Path: "/metadata/labels/team", Value: "platform"}}
+	if !reflect.DeepEqual(ops, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, ops)
+	}
+
+	diff, err = r.Diff(context.Background(), state(map[string]string{"app": "web", "team": "platform"}), config, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Fatalf("expected an empty plan, got %#v", diff.Attributes)
+	}
+}
+
+func TestFlattenMetadataDefaults(t *testing.T) {
+	r := withDefaultMetadata("kubernetes_config_map_v1", resourceKubernetesConfigMapV1())
+	d := r.TestResourceData()
+	meta := providerMetadata{DefaultLabels: map[string]string{"team": "platform"}}
+	live := metav1.ObjectMeta{Name: "app", Labels: map[string]string{"app": "web", "team": "platform"}}
+
+	out := flattenMetadata(live, d, meta)
+	if labels := out[0].(map[string]interface{})["labels"]; !reflect.DeepEqual(labels, map[string]string{"app": "web"}) {
+		t.Errorf("unexpected labels: %#v", labels)
+	}
+	if labels := d.Get("labels_all"); !reflect.DeepEqual(labels, map[string]interface{}{"app": "web", "team": "platform"}) {
+		t.Errorf("unexpected labels_all: %#v", labels)
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// serverDryRunFunc validates the planned object of d with a dry-run of the
+// request that writes it: its create when update is not set, and otherwise
+// the request sent by the update of the resource. config is the raw
+// configuration of the resource, which d does not carry.
+type serverDryRunFunc func(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error
+
+// withServerDryRun adds a CustomizeDiff step to a resource that sends the
+// planned object to the API server with dryRun, so that rejections show up in
+// the plan.
+func withServerDryRun(r *schema.Resource, dryRun serverDryRunFunc) *schema.Resource {
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, diff, meta); err != nil {
+				return err
+			}
+		}
+		if pm, ok := meta.(providerMetadata); !ok || !pm.ServerDryRun {
+			return nil
+		}
+		if diff.Id() != "" && !hasChangesExcept(diff, r.Schema, localOnlyAttributes...) {
+			return nil
+		}
+		if !diff.GetRawConfig().IsWhollyKnown() {
+			log.Printf("[DEBUG] Skipping server dry-run of %q, the configuration is not fully known", diff.Id())
+			return nil
+		}
+
+		d := r.Data(nil)
+		d.SetId(diff.Id())
+		for k := range r.Schema {
+			if err := d.Set(k, diff.Get(k)); err != nil {
+				return err
+			}
+		}
+
+		replace := requiresReplace(diff, r.Schema, "")
+		err := dryRun(ctx, d, meta, diff.Id() != "" && !replace, diff.GetRawConfig())
+		switch {
+		case err == nil:
+			return nil
+		case apierrors.IsNotFound(err):
+			// the namespace of the object is created in the same run
+			return nil
+		case replace && apierrors.IsAlreadyExists(err):
+			// the existing object is deleted before it is created again
+			return nil
+		}
+		return serverDryRunError(err, r.Schema)
+	}
+	return r
+}
+
+// dryRunOptions are the options of the requests of a server dry-run.
+var dryRunOptions = []string{metav1.DryRunAll}
+
+// dryRunApply validates obj with a dry-run of the server-side apply of an
+// update, leaving out the fields of computed like applyUpdate does.
+func dryRunApply(ctx context.Context, meta interface{}, config cty.Value, apiVersion, kind string, obj runtime.Object, computed map[string][]string) error {
+	pm := meta.(providerMetadata)
+	_, err := applyObject(ctx, meta, apiVersion, kind, obj, metav1.PatchOptions{
+		FieldManager: pm.ServerSideApply.FieldManager,
+		Force:        &pm.ServerSideApply.ForceConflicts,
+		DryRun:       dryRunOptions,
+	}, omittedFields(config, computed)...)
+	return err
+}
+
+// dryRunPatch validates obj with a dry-run of a JSON patch, the request of the
+// updates made without server-side apply, that sets the labels, annotations
+// and top-level fields of obj on the existing object.
+func dryRunPatch[T any](ctx context.Context, obj runtime.Object, patch func(context.Context, string, types.PatchType, []byte, metav1.PatchOptions, ...string) (T, error)) error {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return err
+	}
+	accessor, err := apimeta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	ops := PatchOperations{}
+	if labels := accessor.GetLabels(); labels != nil {
+		ops = append(ops, &AddOperation{Path: "/metadata/labels", Value: labels})
+	}
+	if annotations := accessor.GetAnnotations(); annotations != nil {
+		ops = append(ops, &AddOperation{Path: "/metadata/annotations", Value: annotations})
+	}
+	fields := make([]string, 0, len(u))
+	for k := range u {
+		switch k {
+		case "apiVersion", "kind", "metadata", "status":
+		default:
+			fields = append(fields, k)
+		}
+	}
+	sort.Strings(fields)
+	for _, k := range fields {
+		ops = append(ops, &AddOperation{Path: "/" + escapeJsonPointer(k), Value: u[k]})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	_, err = patch(ctx, accessor.GetName(), types.JSONPatchType, data, metav1.PatchOptions{DryRun: dryRunOptions})
+	return err
+}
+
+func hasChangesExcept(diff *schema.ResourceDiff, s map[string]*schema.Schema, except ...string) bool {
+	for k := range s {
+		skip := false
+		for _, e := range except {
+			if k == e {
+				skip = true
+			}
+		}
+		if !skip && diff.HasChange(k) {
+			return true
+		}
+	}
+	return false
+}
+
+// requiresReplace reports whether an attribute that forces a new resource has changed.
+func requiresReplace(diff *schema.ResourceDiff, s map[string]*schema.Schema, prefix string) bool {
+	// immutable objects are replaced on any change
+	if _, ok := s["immutable"]; ok && prefix == "" {
+		if old, _ := diff.GetChange("immutable"); old == true {
+			return true
+		}
+	}
+	for k, v := range s {
+		key := prefix + k
+		if v.ForceNew && diff.HasChange(key) {
+			return true
+		}
+		elem, ok := v.Elem.(*schema.Resource)
+		if !ok || v.Type != schema.TypeList {
+			continue
+		}
+		items, _ := diff.Get(key).([]interface{})
+		for i := range items {
+			if requiresReplace(diff, elem.Schema, fmt.Sprintf("%s.%d.", key, i)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// serverDryRunError lists the causes of a rejected dry-run against the
+// attributes of the resource they relate to.
+func serverDryRunError(err error, s map[string]*schema.Schema) error {
+	var status apierrors.APIStatus
+	if !errors.As(err, &status) || status.Status().Details == nil || len(status.Status().Details.Causes) == 0 {
+		return fmt.Errorf("server dry-run failed: %s", err)
+	}
+	var causes []string
+	for _, c := range status.Status().Details.Causes {
+		path := attributePathFromFieldPath(s, c.Field)
+		if path == "" {
+			path = c.Field
+		}
+		if path == "" {
+			causes = append(causes, c.Message)
+			continue
+		}
+		causes = append(causes, fmt.Sprintf("%s: %s", path, c.Message))
+	}
+	return fmt.Errorf("server dry-run failed: %s\n\n%s", status.Status().Message, strings.Join(causes, "\n"))
+}
+
+var fieldPathTokens = regexp.MustCompile(`([^.\[\]]+)|\[([^\]]*)\]`)
+
+// attributePathFromFieldPath converts the path of an object field reported by
+// the API server, e.g. `spec.template.spec.containers[0].image`, to the path of
+// the matching resource attribute, e.g. `spec.0.template.0.spec.0.container.0.image`.
+// The path is cut at the first field that has no matching attribute.
+func attributePathFromFieldPath(s map[string]*schema.Schema, fieldPath string) string {
+	var path []string
+	current := s
+	tokens := fieldPathTokens.FindAllStringSubmatch(fieldPath, -1)
+	for i, t := range tokens {
+		if strings.HasPrefix(t[0], "[") {
+			if len(path) == 0 {
+				break
+			}
+			path = append(path, t[2])
+			continue
+		}
+		if current == nil {
+			break
+		}
+		key, v := lookupAttribute(current, t[1])
+		if v == nil {
+			break
+		}
+		path = append(path, key)
+		current = nil
+		if elem, ok := v.Elem.(*schema.Resource); ok {
+			current = elem.Schema
+			if i+1 == len(tokens) || !strings.HasPrefix(tokens[i+1][0], "[") {
+				path = append(path, "0")
+			}
+		}
+	}
+	return strings.Join(path, ".")
+}
+
+// lookupAttribute finds the attribute of an object field, whose name is in
+// snake case and often singular for lists.
+func lookupAttribute(s map[string]*schema.Schema, field string) (string, *schema.Schema) {
+	name := toSnakeCase(field)
+	candidates := []string{name}
+	if strings.HasSuffix(name, "ies") {
+		candidates = append(candidates, strings.TrimSuffix(name, "ies")+"y")
+	}
+	if strings.HasSuffix(name, "s") {
+		candidates = append(candidates, strings.TrimSuffix(name, "s"))
+	}
+	for _, c := range candidates {
+		if v, ok := s[c]; ok {
+			return c, v
+		}
+	}
+	return "", nil
+}
+
+func toSnakeCase(s string) string {
+	var b strings.Builder
+	var prev rune
+	for _, r := range s {
+		if unicode.IsUpper(r) && unicode.IsLower(prev) {
+			b.WriteRune('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+		prev = r
+	}
+	return b.String()
+}
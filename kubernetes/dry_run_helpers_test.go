@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestAttributePathFromFieldPath(t *testing.T) {
+	deployment := resourceKubernetesDeploymentV1().Schema
+	service := resourceKubernetesServiceV1().Schema
+	roleBinding := resourceKubernetesRoleBindingV1().Schema
+
+	cases := []struct {
+		fieldPath string
+		expected  string
+	}{
+		{"metadata.name", "metadata.0.name"},
+		{"metadata.labels[app]", "metadata.0.labels.app"},
+		{"spec.template.spec.containers[0].image", "spec.0.template.0.spec.0.container.0.image"},
+		{"spec.template.spec.containers[1].volumeMounts[0].mountPath", "spec.0.template.0.spec.0.container.1.volume_mount.0.mount_path"},
+		{"spec.selector", "spec.0.selector.0"},
+		{"spec.unknownField.value", "spec.0"},
+		{"status.replicas", ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.fieldPath, func(t *testing.T) {
+			if path := attributePathFromFieldPath(deployment, tc.fieldPath); path != tc.expected {
+				t.Errorf("expected %q got %q", tc.expected, path)
+			}
+		})
+	}
+
+	if path := attributePathFromFieldPath(service, "spec.clusterIP"); path != "spec.0.cluster_ip" {
+		t.Errorf("expected %q got %q", "spec.0.cluster_ip", path)
+	}
+	if path := attributePathFromFieldPath(roleBinding, "roleRef.apiGroup"); path != "role_ref.0.api_group" {
+		t.Errorf("expected %q got %q", "role_ref.0.api_group", path)
+	}
+}
+
+func TestServerDryRunError(t *testing.T) {
+	s := resourceKubernetesDeploymentV1().Schema
+	gk := schema.GroupKind{Group: "apps", Kind: "Deployment"}
+
+	invalid := apierrors.NewInvalid(gk, "test", field.ErrorList{
+		field.Invalid(field.NewPath("spec", "template", "spec", "containers").Index(0).Child("image"), "", "must not be empty"),
+	})
+	expected := fmt.Sprintf("server dry-run failed: %s\n\n%s", invalid.ErrStatus.Message,
+		"spec.0.template.0.spec.0.container.0.image: Invalid value: \"\": must not be empty")
+	if err := serverDryRunError(invalid, s); err.Error() != expected {
+		t.Errorf("expected %q got %q", expected, err.Error())
+	}
+
+	forbidden := apierrors.NewForbidden(schema.GroupResource{Group: "apps", Resource: "deployments"}, "test", fmt.Errorf("exceeded quota"))
+	forbidden.ErrStatus.Details.Causes = nil
+	expected = "server dry-run failed: " + forbidden.Error()
+	if err := serverDryRunError(forbidden, s); err.Error() != expected {
+		t.Errorf("expected %q got %q", expected, err.Error())
+	}
+
+	wrapped := fmt.Errorf("field manager conflict: %w", apierrors.NewConflict(schema.GroupResource{Resource: "deployments"}, "test", fmt.Errorf("conflict")))
+	if err := serverDryRunError(wrapped, s); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestToSnakeCase(t *testing.T) {
+	cases := map[string]string{
+		"name":         "name",
+		"volumeMounts": "volume_mounts",
+		"clusterIP":    "cluster_ip",
+		"externalIPs":  "external_ips",
+		"apiGroup":     "api_group",
+		"matchLabels":  "match_labels",
+	}
+	for in, expected := range cases {
+		if out := toSnakeCase(in); out != expected {
+			t.Errorf("%q: expected %q got %q", in, expected, out)
+		}
+	}
+}
+
+func TestDryRunPatch(t *testing.T) {
+	cfgMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+			Labels:    map[string]string{"app": "test"},
+		},
+		Data: map[string]string{"key": "value"},
+	}
+
+	var name string
+	var patch string
+	var opts metav1.PatchOptions
+	err := dryRunPatch(context.Background(), cfgMap, func(_ context.Context, n string, pt types.PatchType, data []byte, o metav1.PatchOptions, _ ...string) (*corev1.ConfigMap, error) {
+		if pt != types.JSONPatchType {
+			t.Errorf("expected a JSON patch, got %q", pt)
+		}
+		name, patch, opts = n, string(data), o
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `[{"path":"/metadata/labels","value":{"app":"test"},"op":"add"},{"path":"/data","value":{"key":"value"},"op":"add"}]`
+	if name != "test" {
+		t.Errorf("expected %q got %q", "test", name)
+	}
+	if patch != expected {
+		t.Errorf("expected %s got %s", expected, patch)
+	}
+	if len(opts.DryRun) != 1 || opts.DryRun[0] != metav1.DryRunAll {
+		t.Errorf("expected a dry-run, got %v", opts.DryRun)
+	}
+}
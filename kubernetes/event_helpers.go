@@ -7,11 +7,15 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"sort"
+	"time"
 
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -33,45 +37,76 @@ func getLastWarningsForObject(ctx context.Context, conn *kubernetes.Clientset, m
 		return nil, err
 	}
 
-	// It would be better to sort & filter on the server-side
-	// but API doesn't seem to support it
-	var warnings []api.Event
-
-	// Bring latest events to the top, for easy access
-	sort.Slice(out.Items, func(i, j int) bool {
-		return out.Items[i].LastTimestamp.After(out.Items[j].LastTimestamp.Time)
-	})
-
 	log.Printf("[DEBUG] Received %d events for %s/%s (%s)",
 		len(out.Items), metadata.Namespace, metadata.Name, kind)
 
-	warnCount := 0
-	uniqueWarnings := make(map[string]api.Event, 0)
-	for _, e := range out.Items {
-		if warnCount >= limit {
-			break
-		}
+	return util.LastWarnings(out.Items, limit), nil
+}
 
-		if e.Type == api.EventTypeWarning {
-			_, found := uniqueWarnings[e.Message]
-			if found {
-				continue
-			}
-			warnings = append(warnings, e)
-			uniqueWarnings[e.Message] = e
-			warnCount++
+// describeWorkloadFailure adds to the error of a workload that failed to roll
+// out or to complete the last warnings of the workload, of its ReplicaSets and
+// of its pods, and the state and last log lines of its failing containers.
+func describeWorkloadFailure(ctx context.Context, conn *kubernetes.Clientset, kind, namespace, name string, err error) error {
+	// the context of the operation is done when the wait timed out
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+	defer cancel()
+
+	var uid types.UID
+	var selector *metav1.LabelSelector
+	var getErr error
+	switch kind {
+	case "Deployment":
+		var obj *appsv1.Deployment
+		obj, getErr = conn.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+		if getErr == nil {
+			uid, selector = obj.UID, obj.Spec.Selector
+		}
+	case "StatefulSet":
+		var obj *appsv1.StatefulSet
+		obj, getErr = conn.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if getErr == nil {
+			uid, selector = obj.UID, obj.Spec.Selector
+		}
+	case "DaemonSet":
+		var obj *appsv1.DaemonSet
+		obj, getErr = conn.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if getErr == nil {
+			uid, selector = obj.UID, obj.Spec.Selector
+		}
+	case "Job":
+		var obj *batchv1.Job
+		obj, getErr = conn.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+		if getErr == nil {
+			uid, selector = obj.UID, obj.Spec.Selector
 		}
+	default:
+		return err
+	}
+	if getErr != nil {
+		log.Printf("[DEBUG] Could not describe the failure of %s %s/%s: %s", kind, namespace, name, getErr)
+		return err
 	}
 
-	return warnings, nil
-}
-
-func stringifyEvents(events []api.Event) string {
-	var output string
-	for _, e := range events {
-		output += fmt.Sprintf("\n   * %s (%s): %s: %s",
-			e.InvolvedObject.Name, e.InvolvedObject.Kind,
-			e.Reason, e.Message)
+	pods, uids, podsErr := util.WorkloadPods(ctx, conn, namespace, uid, kind, selector)
+	if podsErr != nil {
+		log.Printf("[DEBUG] Could not list the pods of %s %s/%s: %s", kind, namespace, name, podsErr)
+	}
+	for _, p := range pods {
+		uids[p.UID] = true
+	}
+	var details string
+	warnings, wErr := util.LastWarningEvents(ctx, conn, namespace, uids, util.FailureWarningsLimit)
+	if wErr != nil {
+		log.Printf("[DEBUG] Could not list the events of %s %s/%s: %s", kind, namespace, name, wErr)
+	}
+	if len(warnings) > 0 {
+		details += "\n\nLast warnings:" + util.StringifyEvents(warnings)
+	}
+	if failures := util.DescribeContainerFailures(ctx, conn, pods, util.FailureContainersLimit, util.FailureLogLines); failures != "" {
+		details += "\n\nFailing containers:" + failures
+	}
+	if details == "" {
+		return err
 	}
-	return output
+	return fmt.Errorf("%w%s", err, details)
 }
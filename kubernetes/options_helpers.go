@@ -3,11 +3,211 @@
 
 package kubernetes
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
 
-var (
-	cascadeDeletePolicy = metav1.DeletePropagationForeground
-	deleteOptions       = metav1.DeleteOptions{
-		PropagationPolicy: &cascadeDeletePolicy,
-	}
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
 )
+
+var cascadeDeletePolicy = metav1.DeletePropagationForeground
+
+// localOnlyAttributes are resource attributes that only change how the provider
+// manages a resource and are never sent to the Kubernetes API.
+var localOnlyAttributes = []string{"delete_options", "destroy_behavior"}
+
+func deleteOptionsFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"propagation_policy": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Whether and how garbage collection is performed on the dependents of the deleted object. One of `Orphan`, `Background` or `Foreground`.",
+			ValidateFunc: validation.StringInSlice([]string{
+				string(metav1.DeletePropagationOrphan),
+				string(metav1.DeletePropagationBackground),
+				string(metav1.DeletePropagationForeground),
+			}, false),
+		},
+		"grace_period_seconds": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The duration in seconds before the object should be deleted. Zero means delete immediately.",
+			ValidateFunc: validateTypeStringNullableNonNegativeInt,
+		},
+	}
+}
+
+func deleteOptionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Options used when deleting the resource. Takes precedence over the provider `delete_options` block.",
+		Elem: &schema.Resource{
+			Schema: deleteOptionsFields(),
+		},
+	}
+}
+
+// expandDeleteOptions merges the provider level delete options with the
+// `delete_options` block of the resource, the latter taking precedence.
+func expandDeleteOptions(d *schema.ResourceData, meta interface{}) metav1.DeleteOptions {
+	opts := metav1.DeleteOptions{}
+	if pm, ok := meta.(providerMetadata); ok {
+		opts.PropagationPolicy = pm.DeleteOptions.PropagationPolicy
+		opts.GracePeriodSeconds = pm.DeleteOptions.GracePeriodSeconds
+	}
+	if v, ok := d.Get("delete_options").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		overrideDeleteOptions(&opts, v[0].(map[string]interface{}))
+	}
+	return opts
+}
+
+func overrideDeleteOptions(opts *metav1.DeleteOptions, in map[string]interface{}) {
+	if v, ok := in["propagation_policy"].(string); ok && v != "" {
+		policy := metav1.DeletionPropagation(v)
+		opts.PropagationPolicy = &policy
+	}
+	if v, ok := in["grace_period_seconds"].(string); ok && v != "" {
+		if seconds, err := strconv.ParseInt(v, 10, 64); err == nil {
+			opts.GracePeriodSeconds = &seconds
+		}
+	}
+}
+
+func destroyBehaviorSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "What to do with the object when the resource is destroyed. `delete` (the default) deletes it, `abandon` leaves it in the cluster and releases the fields and labels managed by Terraform.",
+		ValidateFunc: validation.StringInSlice([]string{
+			util.DestroyBehaviorDelete,
+			util.DestroyBehaviorAbandon,
+		}, false),
+	}
+}
+
+func shouldAbandon(d *schema.ResourceData) bool {
+	v, _ := d.Get("destroy_behavior").(string)
+	return v == util.DestroyBehaviorAbandon
+}
+
+// abandonResource leaves the object of a destroyed resource in the cluster and
+// releases the fields and labels managed by Terraform.
+func abandonResource(ctx context.Context, d *schema.ResourceData, meta interface{}, apiVersion, kind string) diag.Diagnostics {
+	name, _ := d.Get("metadata.0.name").(string)
+	namespace, _ := d.Get("metadata.0.namespace").(string)
+
+	rs, err := dynamicResourceInterface(meta, apiVersion, kind, namespace)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := util.AbandonOptions{
+		Name:         name,
+		FieldManager: fieldManagerName(meta),
+		// objects written without server-side apply are managed by the legacy field manager
+		UpdateManagers: []string{legacyFieldManagerName},
+	}
+
+	log.Printf("[INFO] Abandoning %s %q", kind, name)
+	if err := util.AbandonObject(ctx, rs, opts); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Failed to abandon %s %q", kind, name),
+			Detail:   err.Error(),
+		}}
+	}
+	return nil
+}
+
+// restMapping returns the REST mapping of the given kind.
+func restMapping(m interface{}, apiVersion, kind string) (*meta.RESTMapping, error) {
+	dc, err := m.(KubeClientsets).DiscoveryClient()
+	if err != nil {
+		return nil, err
+	}
+	agr, err := restmapper.GetAPIGroupResources(dc)
+	if err != nil {
+		return nil, err
+	}
+	gv, err := k8sschema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	return restmapper.NewDiscoveryRESTMapper(agr).RESTMapping(gv.WithKind(kind).GroupKind(), gv.Version)
+}
+
+// dynamicResourceInterface returns a dynamic client for the objects of the given kind.
+func dynamicResourceInterface(m interface{}, apiVersion, kind, namespace string) (dynamic.ResourceInterface, error) {
+	conn, err := m.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return nil, err
+	}
+	mapping, err := restMapping(m, apiVersion, kind)
+	if err != nil {
+		return nil, err
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return conn.Resource(mapping.Resource).Namespace(namespace), nil
+	}
+	return conn.Resource(mapping.Resource), nil
+}
+
+// withLocalOnlyUpdates wraps the update function of a resource so that changes
+// limited to local-only attributes are saved to state without calling the API.
+func withLocalOnlyUpdates(r *schema.Resource) *schema.Resource {
+	update := r.UpdateContext
+	if update == nil {
+		return r
+	}
+	hasLocalOnly := false
+	for _, k := range localOnlyAttributes {
+		if _, ok := r.Schema[k]; ok {
+			hasLocalOnly = true
+		}
+	}
+	if !hasLocalOnly {
+		return r
+	}
+	r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if !d.HasChangesExcept(localOnlyAttributes...) {
+			return nil
+		}
+		return update(ctx, d, meta)
+	}
+	return r
+}
+
+// expandTargetMetadata expands the metadata of the object that a resource such as
+// kubernetes_labels manages a part of. A namespaced object that does not set a
+// namespace is resolved to the provider namespace once, and the namespace is saved
+// to the metadata so that the resource keeps pointing to the same object when the
+// provider namespace changes.
+func expandTargetMetadata(d *schema.ResourceData, m interface{}) (metav1.ObjectMeta, error) {
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	if metadata.Namespace == "" {
+		mapping, err := restMapping(m, d.Get("api_version").(string), d.Get("kind").(string))
+		if err != nil {
+			return metadata, err
+		}
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			metadata.Namespace = defaultNamespace(m)
+		}
+	}
+	err := d.Set("metadata", []interface{}{map[string]interface{}{
+		"name":      metadata.Name,
+		"namespace": metadata.Namespace,
+	}})
+	return metadata, err
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestExpandDeleteOptions(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"delete_options": deleteOptionsSchema(),
+	}
+	providerDefault := providerMetadata{
+		DeleteOptions: metav1.DeleteOptions{
+			PropagationPolicy:  ptr.To(metav1.DeletePropagationBackground),
+			GracePeriodSeconds: ptr.To(int64(30)),
+		},
+	}
+
+	cases := []struct {
+		name     string
+		raw      map[string]interface{}
+		meta     interface{}
+		expected metav1.DeleteOptions
+	}{
+		{
+			name:     "unset",
+			raw:      map[string]interface{}{},
+			meta:     providerMetadata{},
+			expected: metav1.DeleteOptions{},
+		},
+		{
+			name:     "provider default",
+			raw:      map[string]interface{}{},
+			meta:     providerDefault,
+			expected: providerDefault.DeleteOptions,
+		},
+		{
+			name: "resource overrides provider default",
+			raw: map[string]interface{}{
+				"delete_options": []interface{}{
+					map[string]interface{}{
+						"propagation_policy":   "Orphan",
+						"grace_period_seconds": "0",
+					},
+				},
+			},
+			meta: providerDefault,
+			expected: metav1.DeleteOptions{
+				PropagationPolicy:  ptr.To(metav1.DeletePropagationOrphan),
+				GracePeriodSeconds: ptr.To(int64(0)),
+			},
+		},
+		{
+			name: "partial override",
+			raw: map[string]interface{}{
+				"delete_options": []interface{}{
+					map[string]interface{}{
+						"propagation_policy": "Foreground",
+					},
+				},
+			},
+			meta: providerDefault,
+			expected: metav1.DeleteOptions{
+				PropagationPolicy:  ptr.To(metav1.DeletePropagationForeground),
+				GracePeriodSeconds: ptr.To(int64(30)),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, s, tc.raw)
+			opts := expandDeleteOptions(d, tc.meta)
+			if diff := cmp.Diff(tc.expected, opts); diff != "" {
+				t.Errorf("unexpected delete options (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
+)
+
+// resourceKinds maps resource names, without the `kubernetes_` prefix and the
+// version suffix, to the kind of the object they manage. Resources that are
+// not listed take the kind from their `kind` attribute.
+var resourceKinds = map[string]string{
+	"api_service":                      "APIService",
+	"certificate_signing_request":      "CertificateSigningRequest",
+	"cluster_role":                     "ClusterRole",
+	"cluster_role_binding":             "ClusterRoleBinding",
+	"config_map":                       "ConfigMap",
+	"config_map_v1_data":               "ConfigMap",
+	"cron_job":                         "CronJob",
+	"csi_driver":                       "CSIDriver",
+	"daemon_set":                       "DaemonSet",
+	"daemonset":                        "DaemonSet",
+	"default_service_account":          "ServiceAccount",
+	"deployment":                       "Deployment",
+	"endpoint_slice":                   "EndpointSlice",
+	"endpoints":                        "Endpoints",
+	"horizontal_pod_autoscaler":        "HorizontalPodAutoscaler",
+	"ingress":                          "Ingress",
+	"ingress_class":                    "IngressClass",
+	"job":                              "Job",
+	"limit_range":                      "LimitRange",
+	"mutating_webhook_configuration":   "MutatingWebhookConfiguration",
+	"namespace":                        "Namespace",
+	"network_policy":                   "NetworkPolicy",
+	"node_drain":                       "Node",
+	"node_taint":                       "Node",
+	"persistent_volume":                "PersistentVolume",
+	"persistent_volume_claim":          "PersistentVolumeClaim",
+	"pod":                              "Pod",
+	"pod_disruption_budget":            "PodDisruptionBudget",
+	"pod_security_policy":              "PodSecurityPolicy",
+	"priority_class":                   "PriorityClass",
+	"replication_controller":           "ReplicationController",
+	"resource_quota":                   "ResourceQuota",
+	"role":                             "Role",
+	"role_binding":                     "RoleBinding",
+	"runtime_class":                    "RuntimeClass",
+	"secret":                           "Secret",
+	"secret_v1_data":                   "Secret",
+	"service":                          "Service",
+	"service_account":                  "ServiceAccount",
+	"stateful_set":                     "StatefulSet",
+	"storage_class":                    "StorageClass",
+	"token_request":                    "TokenRequest",
+	"validating_webhook_configuration": "ValidatingWebhookConfiguration",
+}
+
+var resourceVersionSuffix = regexp.MustCompile(`_v\d+((alpha|beta)\d+)?$`)
+
+func resourceKind(name string) string {
+	name = strings.TrimPrefix(name, "kubernetes_")
+	if k, ok := resourceKinds[name]; ok {
+		return k
+	}
+	return resourceKinds[resourceVersionSuffix.ReplaceAllString(name, "")]
+}
+
+// protectionConfig returns the provider `protected_namespaces` and `protected_kinds` settings.
+func protectionConfig(meta interface{}) *util.Protection {
+	if pm, ok := meta.(providerMetadata); ok {
+		return pm.Protection
+	}
+	return nil
+}
+
+func isReadOnly(meta interface{}) bool {
+	pm, ok := meta.(providerMetadata)
+	return ok && pm.ReadOnly
+}
+
+// withProtection refuses the create, update and delete operations of a
+// resource on protected objects, or on any object when the provider is
+// read-only, before any request is sent to the API server.
+func withProtection(name string, r *schema.Resource) *schema.Resource {
+	kind := resourceKind(name)
+	// typed resources resolve an empty namespace to the provider namespace
+	defaultsNamespace := kind != "" && hasNamespacedMetadata(r)
+	r.CreateContext = wrapWithProtection(util.OperationCreate, kind, defaultsNamespace, r.CreateContext)
+	r.UpdateContext = wrapWithProtection(util.OperationUpdate, kind, defaultsNamespace, r.UpdateContext)
+	r.DeleteContext = wrapWithProtection(util.OperationDelete, kind, defaultsNamespace, r.DeleteContext)
+	return r
+}
+
+func wrapWithProtection[F contextFunc](op, kind string, defaultsNamespace bool, fn F) F {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		p := protectionConfig(meta)
+		readOnly := isReadOnly(meta)
+		if p == nil && !readOnly {
+			return fn(ctx, d, meta)
+		}
+		k := kind
+		if k == "" {
+			k, _ = d.Get("kind").(string)
+		}
+		name, _ := d.Get("metadata.0.name").(string)
+		namespace, _ := d.Get("metadata.0.namespace").(string)
+		if namespace == "" && defaultsNamespace {
+			namespace = defaultNamespace(meta)
+		}
+		if readOnly {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Provider is read-only",
+				Detail:   util.ReadOnlyError(op, k, namespace, name).Error(),
+			}}
+		}
+		err := p.Check(op, k, namespace, name)
+		if err == nil {
+			return fn(ctx, d, meta)
+		}
+		diags := diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Protected object",
+			Detail:   err.Error(),
+		}}
+		if !p.Warn() {
+			return diags
+		}
+		diags[0].Severity = diag.Warning
+		return append(diags, fn(ctx, d, meta)...)
+	}
+}
+
+func hasNamespacedMetadata(r *schema.Resource) bool {
+	m, ok := r.Schema["metadata"]
+	if !ok {
+		return false
+	}
+	elem, ok := m.Elem.(*schema.Resource)
+	if !ok {
+		return false
+	}
+	_, ok = elem.Schema["namespace"]
+	return ok
+}
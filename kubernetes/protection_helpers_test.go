@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
+)
+
+func TestResourceKind(t *testing.T) {
+	for name, r := range Provider().ResourcesMap {
+		if resourceKind(name) != "" {
+			continue
+		}
+		if _, ok := r.Schema["kind"]; !ok {
+			t.Errorf("resource %q has no kind and no kind attribute", name)
+		}
+	}
+	if k := resourceKind("kubernetes_horizontal_pod_autoscaler_v2beta2"); k != "HorizontalPodAutoscaler" {
+		t.Errorf("expected kind HorizontalPodAutoscaler got %q", k)
+	}
+}
+
+func TestWithProtection(t *testing.T) {
+	protection, err := util.NewProtection([]string{"kube-system"}, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	called := false
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("config map", true),
+		},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			called = true
+			return nil
+		},
+	}
+	withProtection("kubernetes_config_map_v1", r)
+
+	d := r.Data(nil)
+	meta := providerMetadata{Namespace: "kube-system", Protection: protection}
+	diags := r.CreateContext(context.Background(), d, meta)
+	if !diags.HasError() || called {
+		t.Fatalf("expected the create in the provider namespace to be refused, got %v", diags)
+	}
+
+	if err := d.Set("metadata", []interface{}{map[string]interface{}{"name": "app", "namespace": "apps"}}); err != nil {
+		t.Fatal(err)
+	}
+	diags = r.CreateContext(context.Background(), d, meta)
+	if diags.HasError() || !called {
+		t.Fatalf("expected the create to be allowed, got %v", diags)
+	}
+
+	called = false
+	if err := d.Set("metadata", []interface{}{map[string]interface{}{"name": "app", "namespace": "kube-system"}}); err != nil {
+		t.Fatal(err)
+	}
+	meta.Protection.Mode = util.ProtectionModeWarn
+	diags = r.CreateContext(context.Background(), d, meta)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !called {
+		t.Fatalf("expected the create to be allowed with a warning, got %v", diags)
+	}
+
+	called = false
+	diags = r.CreateContext(context.Background(), d, providerMetadata{ReadOnly: true})
+	if !diags.HasError() || called {
+		t.Fatalf("expected the create to be refused by a read-only provider, got %v", diags)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	gversion "github.com/hashicorp/go-version"
@@ -21,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
 
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -28,6 +30,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/utils/ptr"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	restclient "k8s.io/client-go/rest"
@@ -213,6 +216,75 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "List of Kubernetes metadata labels to ignore across all resources handled by this provider for situations where external systems are managing certain resource labels. Each item is a regular expression.",
 			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Namespace used by namespaced resources and data sources that do not set one. Defaults to the namespace of the selected kubeconfig context, or `default`.",
+			},
+			"default_labels": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Labels added to the metadata of every resource handled by this provider. Labels set on a resource take precedence.",
+			},
+			"default_annotations": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Annotations added to the metadata of every resource handled by this provider. Annotations set on a resource take precedence.",
+			},
+			"startup_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDuration,
+				Description:  "Maximum time to wait for the Kubernetes API server to report ready on its `/readyz` endpoint when the provider is configured, e.g. `5m`. Useful when the cluster is created in the same run. By default the provider does not wait.",
+			},
+			"delete_options": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Default options used when deleting resources. Can be overridden with the `delete_options` block of each resource.",
+				Elem: &schema.Resource{
+					Schema: deleteOptionsFields(),
+				},
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Refuse every create, update and delete operation before it reaches the API server. Reads, data sources and plan-time dry-runs keep working.",
+			},
+			"protected_namespaces": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "List of namespaces in which objects must not be created, updated or deleted, e.g. `kube-system`. Each item is a namespace name or a glob pattern.",
+			},
+			"protected_kinds": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "List of object kinds that must not be created, updated or deleted, e.g. `CustomResourceDefinition`.",
+			},
+			"protection_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "What to do with operations on objects matched by `protected_namespaces` or `protected_kinds`: `deny` refuses them, `warn` only warns about them. Defaults to `deny`.",
+				ValidateFunc: validation.StringInSlice([]string{util.ProtectionModeDeny, util.ProtectionModeWarn}, false),
+			},
+			"server_dry_run": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Validate typed resources with a server-side dry-run of the planned object when planning, so that objects rejected by the API server or by admission webhooks fail the plan.",
+			},
+			"server_side_apply": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Enable server-side apply for the typed resources that support it. Objects are applied with an apply patch instead of being created and updated.",
+				Elem: &schema.Resource{
+					Schema: serverSideApplyFields(),
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -274,6 +346,7 @@ func Provider() *schema.Provider {
 			"kubernetes_limit_range":                resourceKubernetesLimitRangeV1(),
 			"kubernetes_limit_range_v1":             resourceKubernetesLimitRangeV1(),
 			"kubernetes_node_taint":                 resourceKubernetesNodeTaint(),
+			"kubernetes_node_drain":                 resourceKubernetesNodeDrain(),
 			"kubernetes_persistent_volume":          resourceKubernetesPersistentVolumeV1(),
 			"kubernetes_persistent_volume_v1":       resourceKubernetesPersistentVolumeV1(),
 			"kubernetes_persistent_volume_claim":    resourceKubernetesPersistentVolumeClaimV1(),
@@ -352,8 +425,11 @@ func Provider() *schema.Provider {
 			"kubernetes_csi_driver_v1":    resourceKubernetesCSIDriverV1(),
 
 			// provider helper resources
-			"kubernetes_labels":      resourceKubernetesLabels(),
-			"kubernetes_annotations": resourceKubernetesAnnotations(),
+			"kubernetes_labels":          resourceKubernetesLabels(),
+			"kubernetes_annotations":     resourceKubernetesAnnotations(),
+			"kubernetes_scale":           resourceKubernetesScale(),
+			"kubernetes_patch":           resourceKubernetesPatch(),
+			"kubernetes_container_image": resourceKubernetesContainerImage(),
 
 			// authentication
 			"kubernetes_token_request_v1": resourceKubernetesTokenRequestV1(),
@@ -363,6 +439,16 @@ func Provider() *schema.Provider {
 		},
 	}
 
+	for name, r := range p.ResourcesMap {
+		withProtection(name, r)
+		withDefaultMetadata(name, r)
+		withAPIWarnings(r)
+		withLocalOnlyUpdates(r)
+	}
+	for _, ds := range p.DataSourcesMap {
+		withAPIWarnings(ds)
+	}
+
 	p.ConfigureProvider = func(ctx context.Context, req schema.ConfigureProviderRequest, res *schema.ConfigureProviderResponse) {
 		if req.DeferralAllowed && !req.ResourceData.GetRawConfig().IsWhollyKnown() {
 			res.Deferred = &schema.Deferred{
@@ -382,6 +468,23 @@ type KubeClientsets interface {
 	DiscoveryClient() (discovery.DiscoveryInterface, error)
 }
 
+// DefaultNamespace returns the namespace used by namespaced objects that do not
+// set one, as configured on the provider.
+func DefaultNamespace(meta interface{}) string {
+	return defaultNamespace(meta)
+}
+
+// ProtectionConfig returns the `protected_namespaces` and `protected_kinds`
+// settings of the provider, or nil when nothing is protected.
+func ProtectionConfig(meta interface{}) *util.Protection {
+	return protectionConfig(meta)
+}
+
+// IsReadOnly reports whether the provider is configured with `read_only = true`.
+func IsReadOnly(meta interface{}) bool {
+	return isReadOnly(meta)
+}
+
 type providerMetadata struct {
 	// TODO: this struct has become overloaded we should
 	// rename this or break it into smaller structs
@@ -391,8 +494,16 @@ type providerMetadata struct {
 	dynamicClient       dynamic.Interface
 	discoveryClient     discovery.DiscoveryInterface
 
-	IgnoreAnnotations []string
-	IgnoreLabels      []string
+	IgnoreAnnotations  []string
+	IgnoreLabels       []string
+	Namespace          string
+	DefaultLabels      map[string]string
+	DefaultAnnotations map[string]string
+	DeleteOptions      metav1.DeleteOptions
+	ServerSideApply    *serverSideApplyConfig
+	ServerDryRun       bool
+	Protection         *util.Protection
+	ReadOnly           bool
 }
 
 func (k providerMetadata) MainClientset() (*kubernetes.Clientset, error) {
@@ -456,7 +567,7 @@ func (k providerMetadata) DiscoveryClient() (discovery.DiscoveryInterface, error
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVersion string) (interface{}, diag.Diagnostics) {
 	// Config initialization
-	cfg, diags := initializeConfiguration(d)
+	cfg, namespace, diags := initializeConfiguration(d)
 	if diags.HasError() {
 		return nil, diags
 	}
@@ -470,11 +581,16 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 
 	cfg.UserAgent = fmt.Sprintf("HashiCorp/1.0 Terraform/%s", terraformVersion)
 
+	// Warnings returned by the API server are collected per operation and
+	// surfaced as warning diagnostics rather than being logged by client-go.
+	cfg.WarningHandler = restclient.NoWarnings{}
+	cfg.Wrap(util.WarningTransport)
+
 	if logging.IsDebugOrHigher() {
 		log.Printf("[DEBUG] Enabling HTTP requests/responses tracing")
-		cfg.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
-			return logging.NewSubsystemLoggingHTTPTransport("Kubernetes", rt)
-		}
+		cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return util.NewRedactingLoggingTransport("Kubernetes", rt)
+		})
 	}
 
 	ignoreAnnotations := []string{}
@@ -487,17 +603,75 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		ignoreLabels = expandStringSlice(v)
 	}
 
+	var defaultLabels, defaultAnnotations map[string]string
+	if v, ok := d.Get("default_labels").(map[string]interface{}); ok && len(v) > 0 {
+		defaultLabels = expandStringMap(v)
+	}
+	if v, ok := d.Get("default_annotations").(map[string]interface{}); ok && len(v) > 0 {
+		defaultAnnotations = expandStringMap(v)
+	}
+
+	deleteOptions := metav1.DeleteOptions{}
+	if v, ok := d.Get("delete_options").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		overrideDeleteOptions(&deleteOptions, v[0].(map[string]interface{}))
+	}
+
+	protection, err := util.NewProtection(
+		expandStringSlice(d.Get("protected_namespaces").([]interface{})),
+		expandStringSlice(d.Get("protected_kinds").([]interface{})),
+		d.Get("protection_mode").(string),
+	)
+	if err != nil {
+		return nil, diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid value for protected_namespaces",
+			Detail:        err.Error(),
+			AttributePath: cty.Path{}.IndexString("protected_namespaces"),
+		}}
+	}
+
+	var serverSideApply *serverSideApplyConfig
+	if v, ok := d.Get("server_side_apply").([]interface{}); ok {
+		serverSideApply = expandServerSideApplyConfig(v)
+	}
+
+	if v, ok := d.GetOk("startup_timeout"); ok && cfg.Host != "" {
+		timeout, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Invalid value for startup_timeout",
+				Detail:        err.Error(),
+				AttributePath: cty.Path{}.IndexString("startup_timeout"),
+			}}
+		}
+		diags := waitForAPIServerReady(ctx, cfg, timeout)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
 	m := providerMetadata{
 		config:              cfg,
 		mainClientset:       nil,
 		aggregatorClientset: nil,
 		IgnoreAnnotations:   ignoreAnnotations,
 		IgnoreLabels:        ignoreLabels,
+		Namespace:           namespace,
+		DefaultLabels:       defaultLabels,
+		DefaultAnnotations:  defaultAnnotations,
+		DeleteOptions:       deleteOptions,
+		ServerSideApply:     serverSideApply,
+		ServerDryRun:        d.Get("server_dry_run").(bool),
+		Protection:          protection,
+		ReadOnly:            d.Get("read_only").(bool),
 	}
 	return m, diag.Diagnostics{}
 }
 
-func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, diag.Diagnostics) {
+// initializeConfiguration returns the client configuration and the namespace
+// used by resources that do not set one.
+func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, string, diag.Diagnostics) {
 	diags := make(diag.Diagnostics, 0)
 	overrides := &clientcmd.ConfigOverrides{}
 	loader := &clientcmd.ClientConfigLoadingRules{}
@@ -521,7 +695,7 @@ func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, diag.D
 		for _, p := range configPaths {
 			path, err := homedir.Expand(p)
 			if err != nil {
-				return nil, append(diags, diag.FromErr(err)...)
+				return nil, "", append(diags, diag.FromErr(err)...)
 			}
 
 			log.Printf("[DEBUG] Using kubeconfig: %s", path)
@@ -589,7 +763,7 @@ func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, diag.D
 				Detail:        err.Error(),
 				AttributePath: cty.Path{}.IndexString("host"),
 			}
-			return nil, append(diags, nd)
+			return nil, "", append(diags, nd)
 		}
 		overrides.ClusterInfo.Server = host.String()
 	}
@@ -622,7 +796,7 @@ func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, diag.D
 				Summary:       "Failed to parse 'exec' provider configuration",
 				AttributePath: cty.Path{}.IndexString("exec"),
 			}
-			return nil, append(diags, nd)
+			return nil, "", append(diags, nd)
 		}
 		overrides.AuthInfo.Exec = exec
 	}
@@ -632,6 +806,12 @@ func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, diag.D
 	}
 
 	cc := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loader, overrides)
+
+	namespace := d.Get("namespace").(string)
+	if namespace == "" {
+		namespace = util.ContextNamespace(cc, overrides.CurrentContext)
+	}
+
 	cfg, err := cc.ClientConfig()
 	if err != nil {
 		nd := diag.Diagnostic{
@@ -640,10 +820,31 @@ func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, diag.D
 			Detail:   err.Error(),
 		}
 		log.Printf("[WARN] Provider was supplied an invalid configuration. Further operations likely to fail: %v", err)
-		return nil, append(diags, nd)
+		return nil, namespace, append(diags, nd)
 	}
 
-	return cfg, diags
+	return cfg, namespace, diags
+}
+
+// waitForAPIServerReady blocks until the API server reports ready or the timeout expires
+func waitForAPIServerReady(ctx context.Context, cfg *restclient.Config, timeout time.Duration) diag.Diagnostics {
+	conn, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return diag.Errorf("Failed to configure client: %s", err)
+	}
+
+	log.Printf("[INFO] Waiting up to %s for the Kubernetes API server to become ready", timeout)
+	err = util.WaitForAPIServerReady(ctx, conn.Discovery().RESTClient(), timeout)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Kubernetes API server is not ready",
+			Detail:        err.Error(),
+			AttributePath: cty.Path{}.IndexString("startup_timeout"),
+		}}
+	}
+	log.Printf("[INFO] Kubernetes API server is ready")
+	return nil
 }
 
 var useadmissionregistrationv1beta1 *bool
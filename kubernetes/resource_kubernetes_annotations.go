@@ -45,9 +45,10 @@ func resourceKubernetesAnnotations() *schema.Resource {
 				ForceNew:    true,
 			},
 			"metadata": {
-				Type:     schema.TypeList,
-				Required: true,
-				MaxItems: 1,
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"metadata", "selector"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -58,13 +59,16 @@ func resourceKubernetesAnnotations() *schema.Resource {
 						},
 						"namespace": {
 							Type:        schema.TypeString,
-							Description: "The namespace of the resource.",
+							Description: "The namespace of the resource. The provider namespace is used when it is not set.",
 							Optional:    true,
+							Computed:    true,
 							ForceNew:    true,
 						},
 					},
 				},
 			},
+			"selector": resourceSelectorSchema(),
+			"targets":  selectorTargetsSchema(),
 			"annotations": {
 				Type:         schema.TypeMap,
 				Description:  "A map of annotations to apply to the resource.",
@@ -94,10 +98,19 @@ func resourceKubernetesAnnotations() *schema.Resource {
 }
 
 func resourceKubernetesAnnotationsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	d.SetId(buildIdWithVersionKind(metadata,
-		d.Get("api_version").(string),
-		d.Get("kind").(string)))
+	if s, ok := d.GetOk("selector"); ok {
+		d.SetId(buildSelectorId(d.Get("api_version").(string),
+			d.Get("kind").(string),
+			expandResourceSelector(s.([]interface{}))))
+	} else {
+		metadata, err := expandTargetMetadata(d, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(buildIdWithVersionKind(metadata,
+			d.Get("api_version").(string),
+			d.Get("kind").(string)))
+	}
 	diag := resourceKubernetesAnnotationsUpdate(ctx, d, m)
 	if diag.HasError() {
 		d.SetId("")
@@ -106,12 +119,16 @@ func resourceKubernetesAnnotationsCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceKubernetesAnnotationsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if _, ok := d.GetOk("selector"); ok {
+		return resourceKubernetesAnnotationsReadSelected(ctx, d, m)
+	}
+
 	conn, err := m.(KubeClientsets).DynamicClient()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	gvk, name, namespace, err := util.ParseResourceID(d.Id())
+	gvk, name, namespace, err := util.ParseResourceID(d.Id(), "")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -135,7 +152,7 @@ func resourceKubernetesAnnotationsRead(ctx context.Context, d *schema.ResourceDa
 	var r dynamic.ResourceInterface
 	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
 		if namespace == "" {
-			namespace = "default"
+			namespace = util.DefaultNamespace
 		}
 		r = conn.Resource(mapping.Resource).Namespace(namespace)
 	} else {
@@ -179,12 +196,7 @@ func resourceKubernetesAnnotationsRead(ctx context.Context, d *schema.ResourceDa
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	var templateAnnotations map[string]string
-	if kind == "CronJob" {
-		templateAnnotations, _, err = unstructured.NestedStringMap(res.Object, "spec", "jobTemplate", "spec", "template", "metadata", "annotations")
-	} else {
-		templateAnnotations, _, err = unstructured.NestedStringMap(res.Object, "spec", "template", "metadata", "annotations")
-	}
+	templateAnnotations, err := getTemplateAnnotations(res, kind)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -200,6 +212,59 @@ func resourceKubernetesAnnotationsRead(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
+func resourceKubernetesAnnotationsReadSelected(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, objs, err := selectedObjects(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fieldManagerName := d.Get("field_manager").(string)
+	kind := d.Get("kind").(string)
+	values := make([]map[string]string, len(objs))
+	managed := make([]map[string]interface{}, len(objs))
+	templateValues := make([]map[string]string, len(objs))
+	templateManaged := make([]map[string]interface{}, len(objs))
+	for i, obj := range objs {
+		values[i] = obj.GetAnnotations()
+		managed[i], err = getManagedAnnotations(obj.GetManagedFields(), fieldManagerName)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		templateValues[i], err = getTemplateAnnotations(&obj, kind)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		templateManaged[i], err = getTemplateManagedAnnotations(obj.GetManagedFields(), fieldManagerName, kind)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	configured := d.Get("annotations").(map[string]interface{})
+	annotations := selectedStateValues(configured, values, managed)
+	configuredTemplate := d.Get("template_annotations").(map[string]interface{})
+	templateAnnotations := selectedStateValues(configuredTemplate, templateValues, templateManaged)
+	if len(missingTargets(d, objs)) > 0 {
+		annotations = withoutConfiguredKeys(annotations, configured)
+		templateAnnotations = withoutConfiguredKeys(templateAnnotations, configuredTemplate)
+	}
+	d.Set("annotations", annotations)
+	d.Set("template_annotations", templateAnnotations)
+	return nil
+}
+
+// getTemplateAnnotations returns the annotations of the pod template of res
+func getTemplateAnnotations(res *unstructured.Unstructured, kind string) (map[string]string, error) {
+	var templateAnnotations map[string]string
+	var err error
+	if kind == "CronJob" {
+		templateAnnotations, _, err = unstructured.NestedStringMap(res.Object, "spec", "jobTemplate", "spec", "template", "metadata", "annotations")
+	} else {
+		templateAnnotations, _, err = unstructured.NestedStringMap(res.Object, "spec", "template", "metadata", "annotations")
+	}
+	return templateAnnotations, err
+}
+
 // getManagedAnnotations reads the field manager metadata to discover which fields we're managing
 func getManagedAnnotations(managedFields []v1.ManagedFieldsEntry, manager string) (map[string]interface{}, error) {
 	var annotations map[string]interface{}
@@ -260,6 +325,16 @@ func getTemplateManagedAnnotations(managedFields []v1.ManagedFieldsEntry, manage
 }
 
 func resourceKubernetesAnnotationsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if _, ok := d.GetOk("selector"); ok {
+		diags := updateSelectedObjects(ctx, d, m, func(r dynamic.ResourceInterface, obj unstructured.Unstructured, release bool) diag.Diagnostics {
+			return applyAnnotations(ctx, d, r, obj.GetName(), obj.GetNamespace(), release)
+		})
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		return append(diags, resourceKubernetesAnnotationsRead(ctx, d, m)...)
+	}
+
 	conn, err := m.(KubeClientsets).DynamicClient()
 	if err != nil {
 		return diag.FromErr(err)
@@ -296,7 +371,7 @@ func resourceKubernetesAnnotationsUpdate(ctx context.Context, d *schema.Resource
 	namespacedResource := mapping.Scope.Name() == meta.RESTScopeNameNamespace
 	if namespacedResource {
 		if namespace == "" {
-			namespace = "default"
+			namespace = util.DefaultNamespace
 		}
 		r = conn.Resource(mapping.Resource).Namespace(namespace)
 	} else {
@@ -314,10 +389,26 @@ func resourceKubernetesAnnotationsUpdate(ctx context.Context, d *schema.Resource
 		return diag.Errorf("The resource %q does not exist", name)
 	}
 
+	if !namespacedResource {
+		namespace = ""
+	}
+	diags := applyAnnotations(ctx, d, r, name, namespace, d.Id() == "")
+	if diags.HasError() || d.Id() == "" {
+		// don't try to read if we're deleting
+		return diags
+	}
+	return resourceKubernetesAnnotationsRead(ctx, d, m)
+}
+
+// applyAnnotations server-side applies the annotations of d to a resource, or
+// removes them when release is set.
+func applyAnnotations(ctx context.Context, d *schema.ResourceData, r dynamic.ResourceInterface, name, namespace string, release bool) diag.Diagnostics {
+	kind := d.Get("kind").(string)
+
 	// craft the patch to update the annotations
 	annotations := d.Get("annotations")
 	templateAnnotations := d.Get("template_annotations")
-	if d.Id() == "" {
+	if release {
 		// if we're deleting then just we just patch
 		// with an empty annotations map
 		annotations = map[string]interface{}{}
@@ -326,14 +417,14 @@ func resourceKubernetesAnnotationsUpdate(ctx context.Context, d *schema.Resource
 	patchmeta := map[string]interface{}{
 		"name": name,
 	}
-	if namespacedResource {
+	if namespace != "" {
 		patchmeta["namespace"] = namespace
 	}
 	if _, ok := d.GetOk("annotations"); ok {
 		patchmeta["annotations"] = annotations
 	}
 	patchobj := map[string]interface{}{
-		"apiVersion": apiVersion,
+		"apiVersion": d.Get("api_version"),
 		"kind":       kind,
 		"metadata":   patchmeta,
 	}
@@ -382,12 +473,7 @@ func resourceKubernetesAnnotationsUpdate(ctx context.Context, d *schema.Resource
 		}
 		return diag.FromErr(err)
 	}
-
-	if d.Id() == "" {
-		// don't try to read if we're deleting
-		return nil
-	}
-	return resourceKubernetesAnnotationsRead(ctx, d, m)
+	return nil
 }
 
 func resourceKubernetesAnnotationsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -29,7 +29,9 @@ func resourceKubernetesAPIServiceV1() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": metadataSchema("api_service", true),
+			"metadata":         metadataSchema("api_service", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"spec": {
 				Type:        schema.TypeList,
 				Description: "Spec contains information for locating and communicating with a server. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status",
@@ -111,7 +113,7 @@ func resourceKubernetesAPIServiceV1Create(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
 	svc := v1.APIService{
 		ObjectMeta: metadata,
 		Spec:       expandAPIServiceV1Spec(d.Get("spec").([]interface{})),
@@ -172,7 +174,7 @@ func resourceKubernetesAPIServiceV1Update(ctx context.Context, d *schema.Resourc
 	}
 
 	name := d.Id()
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("spec") {
 		ops = append(ops, &ReplaceOperation{
 			Path:  "/spec",
@@ -195,6 +197,10 @@ func resourceKubernetesAPIServiceV1Update(ctx context.Context, d *schema.Resourc
 }
 
 func resourceKubernetesAPIServiceV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "apiregistration.k8s.io/v1", "APIService")
+	}
+
 	conn, err := meta.(KubeClientsets).AggregatorClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -203,7 +209,7 @@ func resourceKubernetesAPIServiceV1Delete(ctx context.Context, d *schema.Resourc
 	name := d.Id()
 
 	log.Printf("[INFO] Deleting API service: %#v", name)
-	err = conn.ApiregistrationV1().APIServices().Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.ApiregistrationV1().APIServices().Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
@@ -94,7 +94,7 @@ func resourceKubernetesCertificateSigningRequestCreate(ctx context.Context, d *s
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
 	spec, err := expandCertificateSigningRequestSpec(d.Get("spec").([]interface{}))
 	if err != nil {
 		return diag.FromErr(err)
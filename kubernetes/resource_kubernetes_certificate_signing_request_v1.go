@@ -99,7 +99,7 @@ func resourceKubernetesCertificateSigningRequestV1Create(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
 	spec := expandCertificateSigningRequestV1Spec(d.Get("spec").([]interface{}))
 
 	csr := certificates.CertificateSigningRequest{
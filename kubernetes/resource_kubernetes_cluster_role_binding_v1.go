@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -16,7 +17,7 @@ import (
 )
 
 func resourceKubernetesClusterRoleBindingV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "A ClusterRoleBinding may be used to grant permission at the cluster level and in all namespaces",
 		CreateContext: resourceKubernetesClusterRoleBindingV1Create,
 		ReadContext:   resourceKubernetesClusterRoleBindingV1Read,
@@ -27,7 +28,9 @@ func resourceKubernetesClusterRoleBindingV1() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": metadataSchemaRBAC("clusterRoleBinding", true, false),
+			"metadata":         metadataSchemaRBAC("clusterRoleBinding", true, false),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"role_ref": {
 				Type:        schema.TypeList,
 				Description: "RoleRef references the Cluster Role for this binding",
@@ -48,7 +51,7 @@ func resourceKubernetesClusterRoleBindingV1() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, resourceKubernetesClusterRoleBindingV1DryRun)
 }
 
 func resourceKubernetesClusterRoleBindingV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -57,14 +60,9 @@ func resourceKubernetesClusterRoleBindingV1Create(ctx context.Context, d *schema
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	binding := &rbacv1.ClusterRoleBinding{
-		ObjectMeta: metadata,
-		RoleRef:    expandRBACRoleRef(d.Get("role_ref").([]interface{})),
-		Subjects:   expandRBACSubjects(d.Get("subject").([]interface{})),
-	}
+	binding := expandClusterRoleBindingV1(d, meta)
 	log.Printf("[INFO] Creating new ClusterRoleBinding: %#v", binding)
-	binding, err = conn.RbacV1().ClusterRoleBindings().Create(ctx, binding, metav1.CreateOptions{})
+	binding, err = createOrApply(ctx, meta, "rbac.authorization.k8s.io/v1", "ClusterRoleBinding", binding, conn.RbacV1().ClusterRoleBindings().Create)
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -75,6 +73,15 @@ func resourceKubernetesClusterRoleBindingV1Create(ctx context.Context, d *schema
 	return resourceKubernetesClusterRoleBindingV1Read(ctx, d, meta)
 }
 
+// expandClusterRoleBindingV1 builds the cluster role binding of d.
+func expandClusterRoleBindingV1(d *schema.ResourceData, meta interface{}) *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+		RoleRef:    expandRBACRoleRef(d.Get("role_ref").([]interface{})),
+		Subjects:   expandRBACSubjects(d.Get("subject").([]interface{})),
+	}
+}
+
 func resourceKubernetesClusterRoleBindingV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesClusterRoleBindingV1Exists(ctx, d, meta)
 	if err != nil {
@@ -121,6 +128,10 @@ func resourceKubernetesClusterRoleBindingV1Read(ctx context.Context, d *schema.R
 }
 
 func resourceKubernetesClusterRoleBindingV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesClusterRoleBindingV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -128,7 +139,7 @@ func resourceKubernetesClusterRoleBindingV1Update(ctx context.Context, d *schema
 
 	name := d.Id()
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("subject") {
 		diffOps := patchRbacSubject(d)
 		ops = append(ops, diffOps...)
@@ -148,7 +159,41 @@ func resourceKubernetesClusterRoleBindingV1Update(ctx context.Context, d *schema
 	return resourceKubernetesClusterRoleBindingV1Read(ctx, d, meta)
 }
 
+// resourceKubernetesClusterRoleBindingV1Apply updates a ClusterRoleBinding with server-side apply.
+func resourceKubernetesClusterRoleBindingV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	out, err := applyUpdate(ctx, d, meta, "rbac.authorization.k8s.io/v1", "ClusterRoleBinding", expandClusterRoleBindingV1(d, meta), nil)
+	if err != nil {
+		return diag.Errorf("Failed to update ClusterRoleBinding: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated ClusterRoleBinding: %#v", out)
+
+	return resourceKubernetesClusterRoleBindingV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesClusterRoleBindingV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	binding := expandClusterRoleBindingV1(d, meta)
+	client := conn.RbacV1().ClusterRoleBindings()
+	switch {
+	case !update:
+		_, err = client.Create(ctx, binding, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "rbac.authorization.k8s.io/v1", "ClusterRoleBinding", binding, nil)
+	default:
+		err = dryRunPatch(ctx, binding, client.Patch)
+	}
+	return err
+}
+
 func resourceKubernetesClusterRoleBindingV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "rbac.authorization.k8s.io/v1", "ClusterRoleBinding")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -156,7 +201,7 @@ func resourceKubernetesClusterRoleBindingV1Delete(ctx context.Context, d *schema
 
 	name := d.Id()
 	log.Printf("[INFO] Deleting ClusterRoleBinding: %#v", name)
-	err = conn.RbacV1().ClusterRoleBindings().Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.RbacV1().ClusterRoleBindings().Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -16,7 +17,7 @@ import (
 )
 
 func resourceKubernetesClusterRoleV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "A ClusterRole creates a role at the cluster level and in all namespaces.",
 		CreateContext: resourceKubernetesClusterRoleV1Create,
 		ReadContext:   resourceKubernetesClusterRoleV1Read,
@@ -27,7 +28,9 @@ func resourceKubernetesClusterRoleV1() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": metadataSchemaRBAC("clusterRole", true, false),
+			"metadata":         metadataSchemaRBAC("clusterRole", true, false),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"rule": {
 				Type:        schema.TypeList,
 				Description: "List of PolicyRules for this ClusterRole",
@@ -57,7 +60,7 @@ func resourceKubernetesClusterRoleV1() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, resourceKubernetesClusterRoleV1DryRun)
 }
 
 func resourceKubernetesClusterRoleV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -66,18 +69,9 @@ func resourceKubernetesClusterRoleV1Create(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	cRole := rbacv1.ClusterRole{
-		ObjectMeta: metadata,
-		Rules:      expandClusterRoleRules(d.Get("rule").([]interface{})),
-	}
-
-	if v, ok := d.GetOk("aggregation_rule"); ok {
-		cRole.AggregationRule = expandClusterRoleAggregationRule(v.([]interface{}))
-	}
-
+	cRole := expandClusterRoleV1(d, meta)
 	log.Printf("[INFO] Creating new cluster role: %#v", cRole)
-	out, err := conn.RbacV1().ClusterRoles().Create(ctx, &cRole, metav1.CreateOptions{})
+	out, err := createOrApply(ctx, meta, "rbac.authorization.k8s.io/v1", "ClusterRole", cRole, conn.RbacV1().ClusterRoles().Create)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -87,14 +81,30 @@ func resourceKubernetesClusterRoleV1Create(ctx context.Context, d *schema.Resour
 	return resourceKubernetesClusterRoleV1Read(ctx, d, meta)
 }
 
+// expandClusterRoleV1 builds the cluster role of d.
+func expandClusterRoleV1(d *schema.ResourceData, meta interface{}) *rbacv1.ClusterRole {
+	cRole := &rbacv1.ClusterRole{
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+		Rules:      expandClusterRoleRules(d.Get("rule").([]interface{})),
+	}
+	if v, ok := d.GetOk("aggregation_rule"); ok {
+		cRole.AggregationRule = expandClusterRoleAggregationRule(v.([]interface{}))
+	}
+	return cRole
+}
+
 func resourceKubernetesClusterRoleV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesClusterRoleV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	name := d.Id()
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("rule") {
 		diffOps := patchRbacRule(d)
 		ops = append(ops, diffOps...)
@@ -118,6 +128,43 @@ func resourceKubernetesClusterRoleV1Update(ctx context.Context, d *schema.Resour
 	return resourceKubernetesClusterRoleV1Read(ctx, d, meta)
 }
 
+// clusterRoleV1ComputedFields are the fields left out of a server-side apply
+// update when their attribute is not configured, like the rules of an
+// aggregated cluster role that are set by its controller.
+var clusterRoleV1ComputedFields = map[string][]string{
+	"rule": {"rules"},
+}
+
+// resourceKubernetesClusterRoleV1Apply updates a cluster role with server-side apply.
+func resourceKubernetesClusterRoleV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	out, err := applyUpdate(ctx, d, meta, "rbac.authorization.k8s.io/v1", "ClusterRole", expandClusterRoleV1(d, meta), clusterRoleV1ComputedFields)
+	if err != nil {
+		return diag.Errorf("Failed to update ClusterRole: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated ClusterRole: %#v", out)
+
+	return resourceKubernetesClusterRoleV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesClusterRoleV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	cRole := expandClusterRoleV1(d, meta)
+	client := conn.RbacV1().ClusterRoles()
+	switch {
+	case !update:
+		_, err = client.Create(ctx, cRole, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "rbac.authorization.k8s.io/v1", "ClusterRole", cRole, clusterRoleV1ComputedFields)
+	default:
+		err = dryRunPatch(ctx, cRole, client.Patch)
+	}
+	return err
+}
+
 func resourceKubernetesClusterRoleV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesClusterRoleV1Exists(ctx, d, meta)
 	if err != nil {
@@ -163,6 +210,10 @@ func resourceKubernetesClusterRoleV1Read(ctx context.Context, d *schema.Resource
 }
 
 func resourceKubernetesClusterRoleV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "rbac.authorization.k8s.io/v1", "ClusterRole")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -170,7 +221,7 @@ func resourceKubernetesClusterRoleV1Delete(ctx context.Context, d *schema.Resour
 
 	name := d.Id()
 	log.Printf("[INFO] Deleting cluster role: %#v", name)
-	err = conn.RbacV1().ClusterRoles().Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.RbacV1().ClusterRoles().Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,7 +20,7 @@ import (
 )
 
 func resourceKubernetesConfigMapV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "The resource provides mechanisms to inject containers with configuration data while keeping containers agnostic of Kubernetes. Config Map can be used to store fine-grained information like individual properties or coarse-grained information like entire config files or JSON blobs.",
 		CreateContext: resourceKubernetesConfigMapV1Create,
 		ReadContext:   resourceKubernetesConfigMapV1Read,
@@ -97,7 +98,9 @@ func resourceKubernetesConfigMapV1() *schema.Resource {
 			},
 		},
 		Schema: map[string]*schema.Schema{
-			"metadata": namespacedMetadataSchema("config map", true),
+			"metadata":         namespacedMetadataSchema("config map", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"binary_data": {
 				Type:         schema.TypeMap,
 				Description:  "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process. Using this field will require 1.10+ apiserver and kubelet. This field only accepts base64-encoded payloads that will be decoded/encoded before being sent/received to/from the apiserver.",
@@ -115,7 +118,7 @@ func resourceKubernetesConfigMapV1() *schema.Resource {
 				Description: "Immutable, if set to true, ensures that data stored in the ConfigMap cannot be updated (only object metadata can be modified). If not set to true, the field can be modified at any time. Defaulted to nil.",
 			},
 		},
-	}
+	}, resourceKubernetesConfigMapV1DryRun)
 }
 
 func resourceKubernetesConfigMapV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -124,16 +127,9 @@ func resourceKubernetesConfigMapV1Create(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	cfgMap := corev1.ConfigMap{
-		ObjectMeta: metadata,
-		BinaryData: expandBase64MapToByteMap(d.Get("binary_data").(map[string]interface{})),
-		Data:       expandStringMap(d.Get("data").(map[string]interface{})),
-		Immutable:  ptr.To(d.Get("immutable").(bool)),
-	}
-
+	cfgMap := expandConfigMapV1(d, meta)
 	log.Printf("[INFO] Creating new config map: %#v", cfgMap)
-	out, err := conn.CoreV1().ConfigMaps(metadata.Namespace).Create(ctx, &cfgMap, metav1.CreateOptions{})
+	out, err := createOrApply(ctx, meta, "v1", "ConfigMap", cfgMap, conn.CoreV1().ConfigMaps(cfgMap.Namespace).Create)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -143,6 +139,16 @@ func resourceKubernetesConfigMapV1Create(ctx context.Context, d *schema.Resource
 	return resourceKubernetesConfigMapV1Read(ctx, d, meta)
 }
 
+// expandConfigMapV1 builds the config map of d.
+func expandConfigMapV1(d *schema.ResourceData, meta interface{}) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+		BinaryData: expandBase64MapToByteMap(d.Get("binary_data").(map[string]interface{})),
+		Data:       expandStringMap(d.Get("data").(map[string]interface{})),
+		Immutable:  ptr.To(d.Get("immutable").(bool)),
+	}
+}
+
 func resourceKubernetesConfigMapV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesConfigMapV1Exists(ctx, d, meta)
 	if err != nil {
@@ -191,6 +197,10 @@ func resourceKubernetesConfigMapV1Read(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceKubernetesConfigMapV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesConfigMapV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -201,7 +211,7 @@ func resourceKubernetesConfigMapV1Update(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("binary_data") {
 		oldV, newV := d.GetChange("binary_data")
 		diffOps := diffStringMap("/binaryData/", oldV.(map[string]interface{}), newV.(map[string]interface{}))
@@ -237,7 +247,41 @@ func resourceKubernetesConfigMapV1Update(ctx context.Context, d *schema.Resource
 	return resourceKubernetesConfigMapV1Read(ctx, d, meta)
 }
 
+// resourceKubernetesConfigMapV1Apply updates a config map with server-side apply.
+func resourceKubernetesConfigMapV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	out, err := applyUpdate(ctx, d, meta, "v1", "ConfigMap", expandConfigMapV1(d, meta), nil)
+	if err != nil {
+		return diag.Errorf("Failed to update Config Map: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated config map: %#v", out)
+
+	return resourceKubernetesConfigMapV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesConfigMapV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	cfgMap := expandConfigMapV1(d, meta)
+	client := conn.CoreV1().ConfigMaps(cfgMap.Namespace)
+	switch {
+	case !update:
+		_, err = client.Create(ctx, cfgMap, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "v1", "ConfigMap", cfgMap, nil)
+	default:
+		err = dryRunPatch(ctx, cfgMap, client.Patch)
+	}
+	return err
+}
+
 func resourceKubernetesConfigMapV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "v1", "ConfigMap")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -248,7 +292,7 @@ func resourceKubernetesConfigMapV1Delete(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Deleting config map: %#v", name)
-	err = conn.CoreV1().ConfigMaps(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().ConfigMaps(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
@@ -42,8 +42,8 @@ func resourceKubernetesConfigMapV1Data() *schema.Resource {
 							Type:        schema.TypeString,
 							Description: "The namespace of the ConfigMap.",
 							Optional:    true,
+							Computed:    true,
 							ForceNew:    true,
-							Default:     "default",
 						},
 					},
 				},
@@ -69,7 +69,14 @@ func resourceKubernetesConfigMapV1Data() *schema.Resource {
 }
 
 func resourceKubernetesConfigMapV1DataCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithNamespace(d.Get("metadata").([]interface{}), m)
+	err := d.Set("metadata", []interface{}{map[string]interface{}{
+		"name":      metadata.Name,
+		"namespace": metadata.Namespace,
+	}})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	d.SetId(buildId(metadata))
 	diag := resourceKubernetesConfigMapV1DataUpdate(ctx, d, m)
 	if diag.HasError() {
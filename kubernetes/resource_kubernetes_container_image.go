@@ -0,0 +1,361 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/utils/ptr"
+)
+
+func resourceKubernetesContainerImage() *schema.Resource {
+	return &schema.Resource{
+		Description:   "This resource provides a way to manage the image of containers in resources that were created outside of Terraform, such as by Helm or an operator. This resource provides functionality similar to the `kubectl set image` command. The images are set through server-side apply, and are left as they are when the resource is destroyed.",
+		CreateContext: resourceKubernetesContainerImageCreate,
+		ReadContext:   resourceKubernetesContainerImageRead,
+		UpdateContext: resourceKubernetesContainerImageUpdate,
+		DeleteContext: resourceKubernetesContainerImageDelete,
+		CustomizeDiff: resourceKubernetesContainerImageCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"api_version": {
+				Type:        schema.TypeString,
+				Description: "Resource API version",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"kind": {
+				Type:         schema.TypeString,
+				Description:  "Resource Kind",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"CronJob", "DaemonSet", "Deployment", "Pod", "ReplicaSet", "StatefulSet"}, false),
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the resource.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Description: "The namespace of the resource. The provider namespace is used when it is not set.",
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+			"container": {
+				Type:        schema.TypeList,
+				Description: "The containers or initContainers to set the image of.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Description:  "Name of the container or initContainer.",
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"image": {
+							Type:         schema.TypeString,
+							Description:  "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images/",
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"image_pull_policy": {
+							Type:         schema.TypeString,
+							Description:  "Image pull policy. One of Always, Never, IfNotPresent. Left as it is when not set.",
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Always", "Never", "IfNotPresent"}, false),
+						},
+					},
+				},
+			},
+			"wait_for_rollout": {
+				Type:        schema.TypeBool,
+				Description: "Wait for the rollout of the new images to complete. Only supported for Deployments, StatefulSets and DaemonSets.",
+				Optional:    true,
+				Default:     false,
+			},
+			"wait_for_ready": waitForReadySchema(),
+			"force": {
+				Type:        schema.TypeBool,
+				Description: "Force overwriting images that were set or edited outside of Terraform.",
+				Optional:    true,
+			},
+			"field_manager": {
+				Type:         schema.TypeString,
+				Description:  "Set the name of the field manager for the images. A unique name is generated when it is not set.",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		},
+	}
+}
+
+// containerImageRolloutKinds are the kinds that wait_for_rollout can wait for.
+var containerImageRolloutKinds = []string{"DaemonSet", "Deployment", "StatefulSet"}
+
+func resourceKubernetesContainerImageCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	kind := diff.Get("kind").(string)
+	if diff.Get("wait_for_rollout").(bool) && kind != "" && !slices.Contains(containerImageRolloutKinds, kind) {
+		return fmt.Errorf("wait_for_rollout: kind %q has no rollout to wait for, only %s are supported. Use wait_for_ready instead",
+			kind, strings.Join(containerImageRolloutKinds, ", "))
+	}
+	return nil
+}
+
+func resourceKubernetesContainerImageCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if _, ok := d.GetOk("field_manager"); !ok {
+		fieldManager, err := generateFieldManager("container-image")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("field_manager", fieldManager)
+	}
+	metadata, err := expandTargetMetadata(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(buildIdWithVersionKind(metadata,
+		d.Get("api_version").(string),
+		d.Get("kind").(string)))
+	diags := resourceKubernetesContainerImageUpdate(ctx, d, m)
+	if diags.HasError() {
+		d.SetId("")
+	}
+	return diags
+}
+
+// containerImageResourceInterface returns the client for the resource targeted by the ID of d.
+func containerImageResourceInterface(d *schema.ResourceData, m interface{}) (dynamic.ResourceInterface, string, string, error) {
+	gvk, name, namespace, err := util.ParseResourceID(d.Id(), util.DefaultNamespace)
+	if err != nil {
+		return nil, "", "", err
+	}
+	r, err := dynamicResourceInterface(m, gvk.GroupVersion().String(), gvk.Kind, namespace)
+	if err != nil {
+		return nil, "", "", err
+	}
+	return r, name, namespace, nil
+}
+
+// findContainer returns the container or initContainer named name in u, along
+// with the field of the pod spec it was found in.
+func findContainer(u *unstructured.Unstructured, kind, name string) (string, map[string]interface{}, error) {
+	for _, field := range []string{"containers", "initContainers"} {
+		if c, err := getResponseContainer(u, field, name, kind); err == nil {
+			return field, c, nil
+		}
+	}
+	return "", nil, fmt.Errorf("could not find container with name %q", name)
+}
+
+func resourceKubernetesContainerImageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	r, name, _, err := containerImageResourceInterface(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := r.Get(ctx, name, v1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  "Resource deleted",
+				Detail:   fmt.Sprintf("The underlying resource %q has been deleted. You should recreate the underlying resource, or remove it from your configuration.", name),
+			}}
+		}
+		return diag.FromErr(err)
+	}
+
+	kind := d.Get("kind").(string)
+	fieldManager := d.Get("field_manager").(string)
+	containers := []interface{}{}
+	for _, v := range d.Get("container").([]interface{}) {
+		configured := v.(map[string]interface{})
+		containerName := configured["name"].(string)
+		field, c, err := findContainer(res, kind, containerName)
+		if err != nil {
+			// the container is gone, which shows as a change to add it back
+			log.Printf("[WARN] %s", err)
+			continue
+		}
+		managed, err := getManagedContainerFields(res.GetManagedFields(), fieldManager, kind, field, containerName)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		container := map[string]interface{}{
+			"name": containerName,
+		}
+		container["image"], _ = c["image"].(string)
+		// the pull policy is only tracked when it is configured or still owned
+		if _, ok := managed["f:imagePullPolicy"]; ok || configured["image_pull_policy"].(string) != "" {
+			container["image_pull_policy"], _ = c["imagePullPolicy"].(string)
+		}
+		containers = append(containers, container)
+	}
+	d.Set("container", containers)
+	return nil
+}
+
+func resourceKubernetesContainerImageUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	r, name, namespace, err := containerImageResourceInterface(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// check the resource exists before we try and patch it
+	res, err := r.Get(ctx, name, v1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return diag.Errorf("The resource %q does not exist", name)
+		}
+		return diag.FromErr(err)
+	}
+
+	kind := d.Get("kind").(string)
+	podSpec := map[string]interface{}{}
+	for _, v := range d.Get("container").([]interface{}) {
+		c := v.(map[string]interface{})
+		containerName := c["name"].(string)
+		field, _, err := findContainer(res, kind, containerName)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		containerSpec := map[string]interface{}{
+			"name":  containerName,
+			"image": c["image"],
+		}
+		if policy := c["image_pull_policy"].(string); policy != "" {
+			containerSpec["imagePullPolicy"] = policy
+		}
+		list, _ := podSpec[field].([]interface{})
+		podSpec[field] = append(list, containerSpec)
+	}
+
+	var spec interface{} = podSpec
+	path := podTemplateSpecPath(kind)
+	for i := len(path) - 1; i > 0; i-- {
+		spec = map[string]interface{}{path[i]: spec}
+	}
+
+	patch := unstructured.Unstructured{}
+	patch.Object = map[string]interface{}{
+		"apiVersion": d.Get("api_version"),
+		"kind":       kind,
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"spec": spec,
+	}
+	patchbytes, err := patch.MarshalJSON()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Setting the images of %s %q", kind, name)
+	_, err = r.Patch(ctx, name, types.ApplyPatchType, patchbytes, v1.PatchOptions{
+		FieldManager: d.Get("field_manager").(string),
+		Force:        ptr.To(d.Get("force").(bool)),
+	})
+	if err != nil {
+		if errors.IsConflict(err) {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Field manager conflict",
+				Detail:   fmt.Sprintf(`Another client is managing a field Terraform tried to update. Set "force" to true to override: %v`, err),
+			}}
+		}
+		return diag.FromErr(err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	if d.Get("wait_for_rollout").(bool) {
+		if diags := waitForContainerImageRollout(ctx, m, kind, namespace, name, timeout); diags.HasError() {
+			return diags
+		}
+	}
+	if d.Get("wait_for_ready").(bool) {
+		log.Printf("[INFO] Waiting for %s %s/%s to be ready", kind, namespace, name)
+		if err := waitForReady(ctx, r, name, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesContainerImageRead(ctx, d, m)
+}
+
+// waitForContainerImageRollout waits for the rollout of a workload with the
+// same checks as the wait_for_rollout attribute of its typed resource.
+func waitForContainerImageRollout(ctx context.Context, m interface{}, kind, namespace, name string, timeout time.Duration) diag.Diagnostics {
+	conn, err := m.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var f retry.RetryFunc
+	switch kind {
+	case "Deployment":
+		f = waitForDeploymentReplicasFunc(ctx, conn, namespace, name)
+	case "StatefulSet":
+		f = retryUntilStatefulSetRolloutComplete(ctx, conn, namespace, name)
+	case "DaemonSet":
+		f = waitForDaemonSetReplicasFunc(ctx, conn, namespace, name)
+	default:
+		return nil
+	}
+	log.Printf("[INFO] Waiting for %s %s/%s to rollout", kind, namespace, name)
+	if err := retry.RetryContext(ctx, timeout, f); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceKubernetesContainerImageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	r, name, _, err := containerImageResourceInterface(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// the images are left as they are, only their ownership is released
+	if err := util.ReleaseFieldManager(ctx, r, name, d.Get("field_manager").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestAccKubernetesContainerImage_deployment(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	namespace := "default"
+	resourceName := "kubernetes_container_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			createDeployment(name, namespace)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			// the image is left as it is when the resource is destroyed
+			if err := testAccCheckKubernetesDeploymentImage(name, namespace, "busybox:1.36")(s); err != nil {
+				return err
+			}
+			return destroyDeployment(name, namespace)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesContainerImage(name, "busybox:1.35", "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "container.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.image", "busybox:1.35"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.image_pull_policy", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "field_manager"),
+					testAccCheckKubernetesDeploymentImage(name, namespace, "busybox:1.35"),
+				),
+			},
+			{
+				Config: testAccKubernetesContainerImage(name, "busybox:1.36", `"Always"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "container.0.image", "busybox:1.36"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.image_pull_policy", "Always"),
+					testAccCheckKubernetesDeploymentImage(name, namespace, "busybox:1.36"),
+				),
+			},
+		},
+	})
+}
+
+func TestFindContainer(t *testing.T) {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "image": "app:1"},
+			},
+			"initContainers": []interface{}{
+				map[string]interface{}{"name": "migrate", "image": "migrate:1"},
+			},
+		},
+	}}
+
+	field, c, err := findContainer(u, "Pod", "app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if field != "containers" || c["image"] != "app:1" {
+		t.Fatalf("unexpected container %q in %s", c["image"], field)
+	}
+	field, c, err = findContainer(u, "Pod", "migrate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if field != "initContainers" || c["image"] != "migrate:1" {
+		t.Fatalf("unexpected container %q in %s", c["image"], field)
+	}
+	if _, _, err := findContainer(u, "Pod", "missing"); err == nil {
+		t.Fatal("expected an error for a missing container")
+	}
+	if _, _, err := findContainer(u, "Deployment", "app"); err == nil {
+		t.Fatal("expected an error for a container outside of the pod template")
+	}
+}
+
+func TestContainerImageWaitForRolloutKind(t *testing.T) {
+	r := resourceKubernetesContainerImage()
+	config := func(kind string) *sdkterraform.ResourceConfig {
+		return sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+			"api_version":      "apps/v1",
+			"kind":             kind,
+			"wait_for_rollout": true,
+			"metadata":         []interface{}{map[string]interface{}{"name": "test"}},
+			"container":        []interface{}{map[string]interface{}{"name": "app", "image": "app:2"}},
+		})
+	}
+	if _, err := r.Diff(context.Background(), nil, config("Deployment"), nil); err != nil {
+		t.Fatalf("expected wait_for_rollout to be accepted for a Deployment: %s", err)
+	}
+	for _, kind := range []string{"CronJob", "Pod", "ReplicaSet"} {
+		if _, err := r.Diff(context.Background(), nil, config(kind), nil); err == nil {
+			t.Errorf("expected wait_for_rollout to be rejected for a %s", kind)
+		}
+	}
+}
+
+func testAccCheckKubernetesDeploymentImage(name, namespace, image string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		d, err := conn.AppsV1().Deployments(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if got := d.Spec.Template.Spec.Containers[0].Image; got != image {
+			return fmt.Errorf("expected image %q, got %q", image, got)
+		}
+		return nil
+	}
+}
+
+func testAccKubernetesContainerImage(name, image, pullPolicy string) string {
+	return fmt.Sprintf(`resource "kubernetes_container_image" "test" {
+  api_version = "apps/v1"
+  kind        = "Deployment"
+  metadata {
+    name = %q
+  }
+  container {
+    name              = "test"
+    image             = %q
+    image_pull_policy = %s
+  }
+}
+`, name, image, pullPolicy)
+}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -20,7 +21,7 @@ import (
 )
 
 func resourceKubernetesCronJobV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "A Cron Job creates Jobs on a time-based schedule.One CronJob object is like one line of a crontab (cron table) file. It runs a job periodically on a given schedule, written in Cron format.Note: All CronJob `schedule` times are based on the timezone of the master where the job is initiated. For instructions on creating and working with cron jobs, and for an example of a spec file for a cron job, see [Kubernetes reference](https://kubernetes.io/docs/tasks/job/automated-tasks-with-cron-jobs/).",
 		CreateContext: resourceKubernetesCronJobV1Create,
 		ReadContext:   resourceKubernetesCronJobV1Read,
@@ -33,7 +34,9 @@ func resourceKubernetesCronJobV1() *schema.Resource {
 			Delete: schema.DefaultTimeout(1 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
-			"metadata": namespacedMetadataSchema("cronjob", true),
+			"metadata":         namespacedMetadataSchema("cronjob", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"spec": {
 				Type:        schema.TypeList,
 				Description: "Spec of the cron job owned by the cluster",
@@ -44,7 +47,7 @@ func resourceKubernetesCronJobV1() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, resourceKubernetesCronJobV1DryRun)
 }
 
 func resourceKubernetesCronJobV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -53,19 +56,13 @@ func resourceKubernetesCronJobV1Create(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandCronJobSpecV1(d.Get("spec").([]interface{}))
+	job, err := expandCronJobV1(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	job := batch.CronJob{
-		ObjectMeta: metadata,
-		Spec:       spec,
-	}
 	log.Printf("[INFO] Creating new cron job: %#v", job)
 
-	out, err := conn.BatchV1().CronJobs(metadata.Namespace).Create(ctx, &job, metav1.CreateOptions{})
+	out, err := createOrApply(ctx, meta, "batch/v1", "CronJob", job, conn.BatchV1().CronJobs(job.Namespace).Create)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -76,7 +73,23 @@ func resourceKubernetesCronJobV1Create(ctx context.Context, d *schema.ResourceDa
 	return resourceKubernetesCronJobV1Read(ctx, d, meta)
 }
 
+// expandCronJobV1 builds the cron job of d.
+func expandCronJobV1(d *schema.ResourceData, meta interface{}) (*batch.CronJob, error) {
+	spec, err := expandCronJobSpecV1(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &batch.CronJob{
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+		Spec:       spec,
+	}, nil
+}
+
 func resourceKubernetesCronJobV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesCronJobV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -87,7 +100,7 @@ func resourceKubernetesCronJobV1Update(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
 	spec, err := expandCronJobSpecV1(d.Get("spec").([]interface{}))
 	if err != nil {
 		return diag.FromErr(err)
@@ -110,6 +123,56 @@ func resourceKubernetesCronJobV1Update(ctx context.Context, d *schema.ResourceDa
 	return resourceKubernetesCronJobV1Read(ctx, d, meta)
 }
 
+// cronJobV1ComputedFields are the fields left out of a server-side apply
+// update when their attribute is not configured.
+var cronJobV1ComputedFields = map[string][]string{
+	"spec.0.job_template.0.spec.0.completion_mode":                        {"spec", "jobTemplate", "spec", "completionMode"},
+	"spec.0.job_template.0.spec.0.selector":                               {"spec", "jobTemplate", "spec", "selector"},
+	"spec.0.job_template.0.spec.0.template.0.spec.0.hostname":             {"spec", "jobTemplate", "spec", "template", "spec", "hostname"},
+	"spec.0.job_template.0.spec.0.template.0.spec.0.image_pull_secrets":   {"spec", "jobTemplate", "spec", "template", "spec", "imagePullSecrets"},
+	"spec.0.job_template.0.spec.0.template.0.spec.0.node_name":            {"spec", "jobTemplate", "spec", "template", "spec", "nodeName"},
+	"spec.0.job_template.0.spec.0.template.0.spec.0.readiness_gate":       {"spec", "jobTemplate", "spec", "template", "spec", "readinessGates"},
+	"spec.0.job_template.0.spec.0.template.0.spec.0.scheduler_name":       {"spec", "jobTemplate", "spec", "template", "spec", "schedulerName"},
+	"spec.0.job_template.0.spec.0.template.0.spec.0.service_account_name": {"spec", "jobTemplate", "spec", "template", "spec", "serviceAccountName"},
+}
+
+// resourceKubernetesCronJobV1Apply updates a cron job with server-side apply.
+func resourceKubernetesCronJobV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	job, err := expandCronJobV1(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	out, err := applyUpdate(ctx, d, meta, "batch/v1", "CronJob", job, cronJobV1ComputedFields)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Submitted updated cron job: %#v", out)
+
+	return resourceKubernetesCronJobV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesCronJobV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	job, err := expandCronJobV1(d, meta)
+	if err != nil {
+		return err
+	}
+	client := conn.BatchV1().CronJobs(job.Namespace)
+	switch {
+	case !update:
+		_, err = client.Create(ctx, job, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "batch/v1", "CronJob", job, cronJobV1ComputedFields)
+	default:
+		_, err = client.Update(ctx, job, metav1.UpdateOptions{DryRun: dryRunOptions})
+	}
+	return err
+}
+
 func resourceKubernetesCronJobV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesCronJobV1Exists(ctx, d, meta)
 	if err != nil {
@@ -164,6 +227,10 @@ func resourceKubernetesCronJobV1Read(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceKubernetesCronJobV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "batch/v1", "CronJob")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -175,7 +242,7 @@ func resourceKubernetesCronJobV1Delete(ctx context.Context, d *schema.ResourceDa
 	}
 
 	log.Printf("[INFO] Deleting cron job: %#v", name)
-	err = conn.BatchV1().CronJobs(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.BatchV1().CronJobs(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
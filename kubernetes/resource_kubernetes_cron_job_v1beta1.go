@@ -44,7 +44,9 @@ func resourceKubernetesCronJobV1Beta1() *schema.Resource {
 
 func resourceKubernetesCronJobSchemaV1Beta1() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
-		"metadata": namespacedMetadataSchema("cronjob", true),
+		"metadata":         namespacedMetadataSchema("cronjob", true),
+		"delete_options":   deleteOptionsSchema(),
+		"destroy_behavior": destroyBehaviorSchema(),
 		"spec": {
 			Type:        schema.TypeList,
 			Description: "Spec of the cron job owned by the cluster",
@@ -63,7 +65,7 @@ func resourceKubernetesCronJobV1Beta1Create(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
 	spec, err := expandCronJobSpecV1Beta1(d.Get("spec").([]interface{}))
 	if err != nil {
 		return diag.FromErr(err)
@@ -98,7 +100,7 @@ func resourceKubernetesCronJobV1Beta1Update(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
 	spec, err := expandCronJobSpecV1Beta1(d.Get("spec").([]interface{}))
 	if err != nil {
 		return diag.FromErr(err)
@@ -176,6 +178,10 @@ func resourceKubernetesCronJobV1Beta1Read(ctx context.Context, d *schema.Resourc
 }
 
 func resourceKubernetesCronJobV1Beta1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "batch/v1beta1", "CronJob")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -187,7 +193,7 @@ func resourceKubernetesCronJobV1Beta1Delete(ctx context.Context, d *schema.Resou
 	}
 
 	log.Printf("[INFO] Deleting cron job: %#v", name)
-	err = conn.BatchV1beta1().CronJobs(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.BatchV1beta1().CronJobs(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
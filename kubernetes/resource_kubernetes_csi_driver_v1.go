@@ -33,7 +33,9 @@ func resourceKubernetesCSIDriverV1() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": metadataSchema("csi driver", true),
+			"metadata":         metadataSchema("csi driver", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"spec": {
 				Type:        schema.TypeList,
 				Description: "Spec of the CSIDriver",
@@ -78,7 +80,7 @@ func resourceKubernetesCSIDriverV1Create(ctx context.Context, d *schema.Resource
 	}
 
 	CSIDriver := storage.CSIDriver{
-		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
 		Spec:       expandCSIDriverV1Spec(d.Get("spec").([]interface{})),
 	}
 
@@ -137,7 +139,7 @@ func resourceKubernetesCSIDriverV1Update(ctx context.Context, d *schema.Resource
 	}
 
 	name := d.Id()
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("spec") {
 		diffOps := patchCSIDriverV1Spec("spec.0.", "/spec", d)
 		ops = append(ops, *diffOps...)
@@ -158,13 +160,17 @@ func resourceKubernetesCSIDriverV1Update(ctx context.Context, d *schema.Resource
 }
 
 func resourceKubernetesCSIDriverV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "storage.k8s.io/v1", "CSIDriver")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting CSIDriver: %s", d.Id())
-	err = conn.StorageV1().CSIDrivers().Delete(ctx, d.Id(), metav1.DeleteOptions{})
+	err = conn.StorageV1().CSIDrivers().Delete(ctx, d.Id(), expandDeleteOptions(d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -29,7 +29,9 @@ func resourceKubernetesCSIDriverV1Beta1() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
-			"metadata": metadataSchema("csi driver", true),
+			"metadata":         metadataSchema("csi driver", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"spec": {
 				Type:        schema.TypeList,
 				Description: "Spec of the CSIDriver",
@@ -74,7 +76,7 @@ func resourceKubernetesCSIDriverV1Beta1Create(ctx context.Context, d *schema.Res
 	}
 
 	CSIDriver := storage.CSIDriver{
-		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
 		Spec:       expandCSIDriverSpec(d.Get("spec").([]interface{})),
 	}
 
@@ -133,7 +135,7 @@ func resourceKubernetesCSIDriverV1Beta1Update(ctx context.Context, d *schema.Res
 	}
 
 	name := d.Id()
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("spec") {
 		diffOps := patchCSIDriverSpec("spec.0.", "/spec", d)
 		ops = append(ops, *diffOps...)
@@ -154,13 +156,17 @@ func resourceKubernetesCSIDriverV1Beta1Update(ctx context.Context, d *schema.Res
 }
 
 func resourceKubernetesCSIDriverV1Beta1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "storage.k8s.io/v1beta1", "CSIDriver")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting CSIDriver: %s", d.Id())
-	err = conn.StorageV1beta1().CSIDrivers().Delete(ctx, d.Id(), metav1.DeleteOptions{})
+	err = conn.StorageV1beta1().CSIDrivers().Delete(ctx, d.Id(), expandDeleteOptions(d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
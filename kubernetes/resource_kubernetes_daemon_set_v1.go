@@ -10,6 +10,7 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,7 +24,7 @@ import (
 )
 
 func resourceKubernetesDaemonSetV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "A DaemonSet ensures that all (or some) Nodes run a copy of a Pod. As nodes are added to the cluster, Pods are added to them. As nodes are removed from the cluster, those Pods are garbage collected. Deleting a DaemonSet will clean up the Pods it created.",
 		CreateContext: resourceKubernetesDaemonSetV1Create,
 		ReadContext:   resourceKubernetesDaemonSetV1Read,
@@ -46,12 +47,15 @@ func resourceKubernetesDaemonSetV1() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: resourceKubernetesDaemonSetSchemaV1(),
-	}
+	}, resourceKubernetesDaemonSetV1DryRun)
 }
 
 func resourceKubernetesDaemonSetSchemaV1() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
-		"metadata": namespacedMetadataSchema("daemonset", true),
+		"metadata":         namespacedMetadataSchema("daemonset", true),
+		"delete_options":   deleteOptionsSchema(),
+		"destroy_behavior": destroyBehaviorSchema(),
+		"wait_for_ready":   waitForReadySchema(),
 		"spec": {
 			Type:        schema.TypeList,
 			Description: "Spec defines the specification of the desired behavior of the daemonset. More info: https://v1-9.docs.kubernetes.io/docs/reference/generated/kubernetes-api/v1.9/#daemonset-v1-apps",
@@ -142,6 +146,7 @@ func resourceKubernetesDaemonSetSchemaV1() map[string]*schema.Schema {
 			Default:     true,
 			Optional:    true,
 		},
+		"restart_triggers": restartTriggersSchema(),
 	}
 }
 
@@ -151,29 +156,23 @@ func resourceKubernetesDaemonSetV1Create(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandDaemonSetSpec(d.Get("spec").([]interface{}))
+	daemonset, err := expandDaemonSetV1(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	daemonset := appsv1.DaemonSet{
-		ObjectMeta: metadata,
-		Spec:       spec,
-	}
-
 	log.Printf("[INFO] Creating new daemonset: %#v", daemonset)
 
-	out, err := conn.AppsV1().DaemonSets(metadata.Namespace).Create(ctx, &daemonset, metav1.CreateOptions{})
+	out, err := createOrApply(ctx, meta, "apps/v1", "DaemonSet", daemonset, conn.AppsV1().DaemonSets(daemonset.Namespace).Create)
 	if err != nil {
 		return diag.Errorf("Failed to create daemonset: %s", err)
 	}
 
 	if d.Get("wait_for_rollout").(bool) {
 		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
-			waitForDaemonSetReplicasFunc(ctx, conn, metadata.Namespace, metadata.Name))
+			waitForDaemonSetReplicasFunc(ctx, conn, daemonset.Namespace, daemonset.Name))
 		if err != nil {
-			return diag.FromErr(err)
+			return diag.FromErr(describeWorkloadFailure(ctx, conn, "DaemonSet", daemonset.Namespace, daemonset.Name, err))
 		}
 	}
 
@@ -181,10 +180,29 @@ func resourceKubernetesDaemonSetV1Create(ctx context.Context, d *schema.Resource
 
 	log.Printf("[INFO] Submitted new daemonset: %#v", out)
 
+	if diags := waitForObjectReady(ctx, d, meta, "apps/v1", "DaemonSet", out, schema.TimeoutCreate); diags.HasError() {
+		return diags
+	}
+
 	return resourceKubernetesDaemonSetV1Read(ctx, d, meta)
 }
 
+func expandDaemonSetV1(d *schema.ResourceData, meta interface{}) (*appsv1.DaemonSet, error) {
+	spec, err := expandDaemonSetSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &appsv1.DaemonSet{
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+		Spec:       spec,
+	}, nil
+}
+
 func resourceKubernetesDaemonSetV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesDaemonSetV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -195,13 +213,18 @@ func resourceKubernetesDaemonSetV1Update(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 
-	if d.HasChange("spec") {
+	if d.HasChange("spec") || d.HasChange("restart_triggers") {
 		spec, err := expandDaemonSetSpec(d.Get("spec").([]interface{}))
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		live, err := conn.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		restartPodTemplate(d, &spec.Template, live.Spec.Template)
 
 		ops = append(ops, &ReplaceOperation{
 			Path:  "/spec",
@@ -224,13 +247,84 @@ func resourceKubernetesDaemonSetV1Update(ctx context.Context, d *schema.Resource
 		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
 			waitForDaemonSetReplicasFunc(ctx, conn, namespace, name))
 		if err != nil {
-			return diag.FromErr(err)
+			return diag.FromErr(describeWorkloadFailure(ctx, conn, "DaemonSet", namespace, name, err))
 		}
 	}
 
+	if diags := waitForObjectReady(ctx, d, meta, "apps/v1", "DaemonSet", out, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
 	return resourceKubernetesDaemonSetV1Read(ctx, d, meta)
 }
 
+// daemonSetV1ComputedFields are the fields left out of a server-side apply
+// update when their attribute is not configured.
+var daemonSetV1ComputedFields = map[string][]string{
+	"spec.0.strategy": {"spec", "updateStrategy"},
+}
+
+// resourceKubernetesDaemonSetV1Apply updates a daemonset with server-side
+// apply, or validates the update with a server dry-run.
+func resourceKubernetesDaemonSetV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	daemonset, err := expandDaemonSetV1(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	live, err := conn.AppsV1().DaemonSets(daemonset.Namespace).Get(ctx, daemonset.Name, metav1.GetOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	restartPodTemplate(d, &daemonset.Spec.Template, live.Spec.Template)
+
+	out, err := applyUpdate(ctx, d, meta, "apps/v1", "DaemonSet", daemonset, daemonSetV1ComputedFields)
+	if err != nil {
+		return diag.Errorf("Failed to update daemonset: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated daemonset: %#v", out)
+
+	if d.Get("wait_for_rollout").(bool) {
+		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
+			waitForDaemonSetReplicasFunc(ctx, conn, out.Namespace, out.Name))
+		if err != nil {
+			return diag.FromErr(describeWorkloadFailure(ctx, conn, "DaemonSet", out.Namespace, out.Name, err))
+		}
+	}
+
+	if diags := waitForObjectReady(ctx, d, meta, "apps/v1", "DaemonSet", out, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
+	return resourceKubernetesDaemonSetV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesDaemonSetV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	daemonset, err := expandDaemonSetV1(d, meta)
+	if err != nil {
+		return err
+	}
+	client := conn.AppsV1().DaemonSets(daemonset.Namespace)
+	switch {
+	case !update:
+		_, err = client.Create(ctx, daemonset, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "apps/v1", "DaemonSet", daemonset, daemonSetV1ComputedFields)
+	default:
+		err = dryRunPatch(ctx, daemonset, client.Patch)
+	}
+	return err
+}
+
 func resourceKubernetesDaemonSetV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesDaemonSetV1Exists(ctx, d, meta)
 	if err != nil {
@@ -267,6 +361,7 @@ func resourceKubernetesDaemonSetV1Read(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
+	hideRestartedAt(d, &daemonset.Spec.Template)
 	spec, err := flattenDaemonSetSpec(daemonset.Spec, d, meta)
 	if err != nil {
 		return diag.FromErr(err)
@@ -281,6 +376,10 @@ func resourceKubernetesDaemonSetV1Read(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceKubernetesDaemonSetV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "apps/v1", "DaemonSet")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -293,7 +392,7 @@ func resourceKubernetesDaemonSetV1Delete(ctx context.Context, d *schema.Resource
 
 	log.Printf("[INFO] Deleting daemonset: %#v", name)
 
-	err = conn.AppsV1().DaemonSets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.AppsV1().DaemonSets(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
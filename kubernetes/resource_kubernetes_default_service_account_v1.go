@@ -40,7 +40,7 @@ func resourceKubernetesDefaultServiceAccountV1Create(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithNamespace(d.Get("metadata").([]interface{}), meta)
 	svcAcc := corev1.ServiceAccount{ObjectMeta: metadata}
 
 	log.Printf("[INFO] Checking for default service account existence: %s", metadata.Namespace)
@@ -68,7 +68,7 @@ func resourceKubernetesDefaultServiceAccountV1Create(ctx context.Context, d *sch
 	}
 	d.Set("default_secret_name", secret.Name)
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("image_pull_secret") {
 		v := d.Get("image_pull_secret").(*schema.Set).List()
 		ops = append(ops, &ReplaceOperation{
@@ -10,6 +10,7 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -29,7 +30,7 @@ const (
 )
 
 func resourceKubernetesDeploymentV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "A Deployment ensures that a specified number of pod “replicas” are running at any one time. In other words, a Deployment makes sure that a pod or homogeneous set of pods are always up and available. If there are too many pods, it will kill some. If there are too few, the Deployment will start more.",
 		CreateContext: resourceKubernetesDeploymentV1Create,
 		ReadContext:   resourceKubernetesDeploymentV1Read,
@@ -52,12 +53,15 @@ func resourceKubernetesDeploymentV1() *schema.Resource {
 		},
 		SchemaVersion: 1,
 		Schema:        resourceKubernetesDeploymentSchemaV1(),
-	}
+	}, resourceKubernetesDeploymentV1DryRun)
 }
 
 func resourceKubernetesDeploymentSchemaV1() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
-		"metadata": namespacedMetadataSchema("deployment", true),
+		"metadata":         namespacedMetadataSchema("deployment", true),
+		"delete_options":   deleteOptionsSchema(),
+		"destroy_behavior": destroyBehaviorSchema(),
+		"wait_for_ready":   waitForReadySchema(),
 		"spec": {
 			Type:        schema.TypeList,
 			Description: "Spec defines the specification of the desired behavior of the deployment. More info: https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.9/#deployment-v1-apps",
@@ -215,6 +219,13 @@ func resourceKubernetesDeploymentSchemaV1() map[string]*schema.Schema {
 			Default:     true,
 			Optional:    true,
 		},
+		"rollback_on_failure": {
+			Type:        schema.TypeBool,
+			Description: "Roll the deployment back to its previous revision when the rollout of an update fails, like `kubectl rollout undo` does, and wait for it. The rollout error is still returned. Requires `wait_for_rollout`.",
+			Optional:    true,
+			Default:     false,
+		},
+		"restart_triggers": restartTriggersSchema(),
 	}
 }
 
@@ -234,19 +245,13 @@ func resourceKubernetesDeploymentV1Create(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandDeploymentSpec(d.Get("spec").([]interface{}))
+	deployment, err := expandDeploymentV1(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	deployment := appsv1.Deployment{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
-
 	log.Printf("[INFO] Creating new deployment: %#v", deployment)
-	out, err := conn.AppsV1().Deployments(metadata.Namespace).Create(ctx, &deployment, metav1.CreateOptions{})
+	out, err := createOrApply(ctx, meta, "apps/v1", "Deployment", deployment, conn.AppsV1().Deployments(deployment.Namespace).Create)
 	if err != nil {
 		return diag.Errorf("Failed to create deployment: %s", err)
 	}
@@ -255,21 +260,35 @@ func resourceKubernetesDeploymentV1Create(ctx context.Context, d *schema.Resourc
 
 	log.Printf("[DEBUG] Waiting for deployment %s to schedule %d replicas", d.Id(), *out.Spec.Replicas)
 
-	if d.Get("wait_for_rollout").(bool) {
-		log.Printf("[INFO] Waiting for deployment %s/%s to rollout", out.ObjectMeta.Namespace, out.ObjectMeta.Name)
-		err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
-			waitForDeploymentReplicasFunc(ctx, conn, out.GetNamespace(), out.GetName()))
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	if diags := waitForDeploymentV1Rollout(ctx, d, meta, out, schema.TimeoutCreate); diags.HasError() {
+		return diags
 	}
 
 	log.Printf("[INFO] Submitted new deployment: %#v", out)
 
+	if diags := waitForObjectReady(ctx, d, meta, "apps/v1", "Deployment", out, schema.TimeoutCreate); diags.HasError() {
+		return diags
+	}
+
 	return resourceKubernetesDeploymentV1Read(ctx, d, meta)
 }
 
+func expandDeploymentV1(d *schema.ResourceData, meta interface{}) (*appsv1.Deployment, error) {
+	spec, err := expandDeploymentSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &appsv1.Deployment{
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesDeploymentV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesDeploymentV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -280,13 +299,18 @@ func resourceKubernetesDeploymentV1Update(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 
-	if d.HasChange("spec") {
+	if d.HasChange("spec") || d.HasChange("restart_triggers") {
 		spec, err := expandDeploymentSpec(d.Get("spec").([]interface{}))
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		live, err := conn.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		restartPodTemplate(d, &spec.Template, live.Spec.Template)
 
 		ops = append(ops, &ReplaceOperation{
 			Path:  "/spec",
@@ -315,18 +339,144 @@ func resourceKubernetesDeploymentV1Update(ctx context.Context, d *schema.Resourc
 	}
 	log.Printf("[INFO] Submitted updated deployment: %#v", out)
 
-	if d.Get("wait_for_rollout").(bool) {
-		log.Printf("[INFO] Waiting for deployment %s/%s to rollout", out.ObjectMeta.Namespace, out.ObjectMeta.Name)
-		err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
-			waitForDeploymentReplicasFunc(ctx, conn, out.GetNamespace(), out.GetName()))
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	if diags := waitForDeploymentV1Rollout(ctx, d, meta, out, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
+	if diags := waitForObjectReady(ctx, d, meta, "apps/v1", "Deployment", out, schema.TimeoutUpdate); diags.HasError() {
+		return diags
 	}
 
 	return resourceKubernetesDeploymentV1Read(ctx, d, meta)
 }
 
+// deploymentV1ComputedFields are the fields left out of a server-side apply
+// update when their attribute is not configured.
+var deploymentV1ComputedFields = map[string][]string{
+	"spec.0.replicas": {"spec", "replicas"},
+	"spec.0.strategy": {"spec", "strategy"},
+}
+
+// resourceKubernetesDeploymentV1Apply updates a deployment with server-side
+// apply, or validates the update with a server dry-run.
+func resourceKubernetesDeploymentV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deployment, err := expandDeploymentV1(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	live, err := conn.AppsV1().Deployments(deployment.Namespace).Get(ctx, deployment.Name, metav1.GetOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	restartPodTemplate(d, &deployment.Spec.Template, live.Spec.Template)
+
+	out, err := applyUpdate(ctx, d, meta, "apps/v1", "Deployment", deployment, deploymentV1ComputedFields)
+	if err != nil {
+		return diag.Errorf("Failed to update deployment: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated deployment: %#v", out)
+
+	if diags := waitForDeploymentV1Rollout(ctx, d, meta, out, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
+	if diags := waitForObjectReady(ctx, d, meta, "apps/v1", "Deployment", out, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
+	return resourceKubernetesDeploymentV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesDeploymentV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	deployment, err := expandDeploymentV1(d, meta)
+	if err != nil {
+		return err
+	}
+	client := conn.AppsV1().Deployments(deployment.Namespace)
+	switch {
+	case !update:
+		_, err = client.Create(ctx, deployment, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "apps/v1", "Deployment", deployment, deploymentV1ComputedFields)
+	default:
+		err = dryRunPatch(ctx, deployment, client.Patch)
+	}
+	return err
+}
+
+// waitForDeploymentV1Rollout waits for the rollout of a deployment when
+// `wait_for_rollout` is set, and rolls back a failed update when
+// `rollback_on_failure` is set.
+func waitForDeploymentV1Rollout(ctx context.Context, d *schema.ResourceData, meta interface{}, out *appsv1.Deployment, timeout string) diag.Diagnostics {
+	if !d.Get("wait_for_rollout").(bool) {
+		return nil
+	}
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Waiting for deployment %s/%s to rollout", out.ObjectMeta.Namespace, out.ObjectMeta.Name)
+	err = retry.RetryContext(ctx, d.Timeout(timeout),
+		waitForDeploymentReplicasFunc(ctx, conn, out.GetNamespace(), out.GetName()))
+	if err != nil {
+		err = describeWorkloadFailure(ctx, conn, "Deployment", out.GetNamespace(), out.GetName(), err)
+		if timeout == schema.TimeoutUpdate && d.Get("rollback_on_failure").(bool) {
+			return rollbackDeploymentV1(ctx, d, meta, err)
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// rollbackDeploymentV1 rolls back a deployment whose rollout failed and waits
+// for the previous revision to roll out. The rollout error is returned either way.
+func rollbackDeploymentV1(ctx context.Context, d *schema.ResourceData, meta interface{}, rolloutErr error) diag.Diagnostics {
+	diags := diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "Deployment rollout failed",
+		Detail:   rolloutErr.Error(),
+	}}
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	// the context of the update is done when the rollout timed out
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	revision, err := undoDeploymentRollout(ctx, conn, namespace, name)
+	if err != nil {
+		diags[0].Detail += fmt.Sprintf("\n\nThe deployment could not be rolled back: %s", err)
+		return diags
+	}
+	log.Printf("[INFO] Rolled back deployment %s/%s to revision %d", namespace, name, revision)
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
+		waitForDeploymentReplicasFunc(ctx, conn, namespace, name))
+	if err != nil {
+		diags[0].Detail += fmt.Sprintf("\n\nThe deployment was rolled back to revision %d, which did not roll out either: %s", revision, err)
+	} else {
+		diags[0].Detail += fmt.Sprintf("\n\nThe deployment was rolled back to revision %d.", revision)
+	}
+
+	// store the rolled back pod template, so that the next plan applies the update again
+	return append(diags, resourceKubernetesDeploymentV1Read(ctx, d, meta)...)
+}
+
 func resourceKubernetesDeploymentV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesDeploymentV1Exists(ctx, d, meta)
 	if err != nil {
@@ -359,6 +509,7 @@ func resourceKubernetesDeploymentV1Read(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
+	hideRestartedAt(d, &deployment.Spec.Template)
 	spec, err := flattenDeploymentSpec(deployment.Spec, d, meta)
 	if err != nil {
 		return diag.FromErr(err)
@@ -373,6 +524,10 @@ func resourceKubernetesDeploymentV1Read(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceKubernetesDeploymentV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "apps/v1", "Deployment")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -385,7 +540,7 @@ func resourceKubernetesDeploymentV1Delete(ctx context.Context, d *schema.Resourc
 
 	log.Printf("[INFO] Deleting deployment: %#v", name)
 
-	err = conn.AppsV1().Deployments(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.AppsV1().Deployments(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
@@ -45,6 +45,124 @@ func TestAccKubernetesDeploymentV1_minimal(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesDeploymentV1_restartTriggers(t *testing.T) {
+	var conf appsv1.Deployment
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_deployment_v1.test"
+	imageName := busyboxImage
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesDeploymentV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDeploymentV1Config_restartTriggers(name, imageName, "one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "restart_triggers.config", "one"),
+					resource.TestCheckNoResourceAttr(resourceName, "spec.0.template.0.metadata.0.annotations.kubectl.kubernetes.io/restartedAt"),
+					testAccCheckKubernetesDeploymentV1RestartedAt(&conf, false),
+				),
+			},
+			{
+				Config: testAccKubernetesDeploymentV1Config_restartTriggers(name, imageName, "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "restart_triggers.config", "two"),
+					resource.TestCheckNoResourceAttr(resourceName, "spec.0.template.0.metadata.0.annotations.kubectl.kubernetes.io/restartedAt"),
+					testAccCheckKubernetesDeploymentV1RestartedAt(&conf, true),
+				),
+			},
+			{
+				Config:   testAccKubernetesDeploymentV1Config_restartTriggers(name, imageName, "two"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDeploymentV1_serverSideApplyScaled(t *testing.T) {
+	var conf appsv1.Deployment
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_deployment_v1.test"
+	imageName := busyboxImage
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesDeploymentV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDeploymentV1Config_serverSideApply(name, imageName, "one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.replicas", "1"),
+				),
+			},
+			{
+				// scale the deployment the way a HorizontalPodAutoscaler does
+				PreConfig: func() {
+					if err := scaleDeploymentV1(name, 3, "kube-controller-manager"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccKubernetesDeploymentV1Config_serverSideApply(name, imageName, "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.template.0.spec.0.container.0.env.0.value", "two"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.replicas", "3"),
+				),
+			},
+		},
+	})
+}
+
+func scaleDeploymentV1(name string, replicas int32, fieldManager string) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	scale, err := conn.AppsV1().Deployments("default").GetScale(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	scale.Spec.Replicas = replicas
+	_, err = conn.AppsV1().Deployments("default").UpdateScale(ctx, name, scale, metav1.UpdateOptions{FieldManager: fieldManager})
+	return err
+}
+
+func TestAccKubernetesDeploymentV1_rollbackOnFailure(t *testing.T) {
+	var conf appsv1.Deployment
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_deployment_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesDeploymentV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDeploymentV1Config_rollbackOnFailure(name, busyboxImage),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "rollback_on_failure", "true"),
+				),
+			},
+			{
+				Config:      testAccKubernetesDeploymentV1Config_rollbackOnFailure(name, "registry.invalid/tf-acc-test:missing"),
+				ExpectError: regexp.MustCompile("rolled back to revision 1"),
+			},
+			{
+				// the rolled back pod template matches the original configuration
+				Config:   testAccKubernetesDeploymentV1Config_rollbackOnFailure(name, busyboxImage),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccKubernetesDeploymentV1_basic(t *testing.T) {
 	var conf appsv1.Deployment
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -1359,6 +1477,124 @@ func testAccKubernetesDeploymentV1Config_minimal(name, imageName string) string
 `, name, imageName)
 }
 
+func testAccCheckKubernetesDeploymentV1RestartedAt(obj *appsv1.Deployment, restarted bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := obj.Spec.Template.Annotations[restartedAtAnnotation]
+		if ok != restarted {
+			return fmt.Errorf("expected the pod template to have the %s annotation: %t, got annotations %v", restartedAtAnnotation, restarted, obj.Spec.Template.Annotations)
+		}
+		return nil
+	}
+}
+
+func testAccKubernetesDeploymentV1Config_restartTriggers(name, imageName, trigger string) string {
+	return fmt.Sprintf(`resource "kubernetes_deployment_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    replicas = 1
+    selector {
+      match_labels = {
+        TestLabelOne = "one"
+      }
+    }
+    template {
+      metadata {
+        labels = {
+          TestLabelOne = "one"
+        }
+      }
+      spec {
+        container {
+          image   = "%s"
+          name    = "tf-acc-test"
+          command = ["sleep", "300"]
+        }
+        termination_grace_period_seconds = 1
+      }
+    }
+  }
+  restart_triggers = {
+    config = "%s"
+  }
+}
+`, name, imageName, trigger)
+}
+
+func testAccKubernetesDeploymentV1Config_serverSideApply(name, imageName, value string) string {
+	return fmt.Sprintf(`provider "kubernetes" {
+  server_side_apply {}
+}
+
+resource "kubernetes_deployment_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    selector {
+      match_labels = {
+        TestLabelOne = "one"
+      }
+    }
+    template {
+      metadata {
+        labels = {
+          TestLabelOne = "one"
+        }
+      }
+      spec {
+        container {
+          image   = "%s"
+          name    = "tf-acc-test"
+          command = ["sleep", "300"]
+          env {
+            name  = "VALUE"
+            value = "%s"
+          }
+        }
+        termination_grace_period_seconds = 1
+      }
+    }
+  }
+}
+`, name, imageName, value)
+}
+
+func testAccKubernetesDeploymentV1Config_rollbackOnFailure(name, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_deployment_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    replicas                  = 1
+    progress_deadline_seconds = 30
+    selector {
+      match_labels = {
+        TestLabelOne = "one"
+      }
+    }
+    template {
+      metadata {
+        labels = {
+          TestLabelOne = "one"
+        }
+      }
+      spec {
+        container {
+          image   = "%s"
+          name    = "tf-acc-test"
+          command = ["sleep", "300"]
+        }
+        termination_grace_period_seconds = 1
+      }
+    }
+  }
+  rollback_on_failure = true
+}
+`, name, imageName)
+}
+
 func testAccKubernetesDeploymentV1Config_basic(name, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_deployment_v1" "test" {
   metadata {
@@ -28,7 +28,9 @@ func resourceKubernetesEndpointsV1() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": namespacedMetadataSchema("endpoints", true),
+			"metadata":         namespacedMetadataSchema("endpoints", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"subset": {
 				Type:        schema.TypeSet,
 				Description: "Set of addresses and ports that comprise a service. More info: https://kubernetes.io/docs/concepts/services-networking/service/#services-without-selectors",
@@ -46,7 +48,7 @@ func resourceKubernetesEndpointsV1Create(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
 	ep := api.Endpoints{
 		ObjectMeta: metadata,
 		Subsets:    expandEndpointsSubsets(d.Get("subset").(*schema.Set)),
@@ -114,7 +116,7 @@ func resourceKubernetesEndpointsV1Update(ctx context.Context, d *schema.Resource
 		return diag.Errorf("Failed to update endpoints because: %s", err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("subset") {
 		subsets := expandEndpointsSubsets(d.Get("subset").(*schema.Set))
 		ops = append(ops, &ReplaceOperation{
@@ -138,6 +140,10 @@ func resourceKubernetesEndpointsV1Update(ctx context.Context, d *schema.Resource
 }
 
 func resourceKubernetesEndpointsV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "v1", "Endpoints")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -148,7 +154,7 @@ func resourceKubernetesEndpointsV1Delete(ctx context.Context, d *schema.Resource
 		return diag.Errorf("Failed to delete endpoints because: %s", err)
 	}
 	log.Printf("[INFO] Deleting endpoints: %#v", name)
-	err = conn.CoreV1().Endpoints(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().Endpoints(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
@@ -26,7 +26,9 @@ func resourceKubernetesEndpointSliceV1() *schema.Resource {
 		DeleteContext: resourceKubernetesEndpointSliceV1Delete,
 
 		Schema: map[string]*schema.Schema{
-			"metadata": namespacedMetadataSchema("endpoint_slice", true),
+			"metadata":         namespacedMetadataSchema("endpoint_slice", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"address_type": {
 				Type:         schema.TypeString,
 				Description:  "address_type specifies the type of address carried by this EndpointSlice. All addresses in this slice must be the same type. This field is immutable after creation.",
@@ -58,7 +60,7 @@ func resourceKubernetesEndpointSliceV1Create(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
 	endpoint_slice := api.EndpointSlice{
 		ObjectMeta:  metadata,
 		AddressType: api.AddressType(d.Get("address_type").(string)),
@@ -132,7 +134,7 @@ func resourceKubernetesEndpointSliceV1Update(ctx context.Context, d *schema.Reso
 		return diag.Errorf("Failed to update endpointSlice because: %s", err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("address_type") {
 		address_type := d.Get("address_type").(string)
 		ops = append(ops, &ReplaceOperation{
@@ -170,6 +172,10 @@ func resourceKubernetesEndpointSliceV1Update(ctx context.Context, d *schema.Reso
 }
 
 func resourceKubernetesEndpointSliceV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "discovery.k8s.io/v1", "EndpointSlice")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -180,7 +186,7 @@ func resourceKubernetesEndpointSliceV1Delete(ctx context.Context, d *schema.Reso
 		return diag.Errorf("Failed to delete endpointSlice because: %s", err)
 	}
 	log.Printf("[INFO] Deleting endpointSlice: %#v", name)
-	err = conn.DiscoveryV1().EndpointSlices(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.DiscoveryV1().EndpointSlices(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
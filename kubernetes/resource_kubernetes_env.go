@@ -7,12 +7,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-kubernetes/util"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/types"
@@ -50,8 +53,9 @@ func resourceKubernetesEnv() *schema.Resource {
 						},
 						"namespace": {
 							Type:        schema.TypeString,
-							Description: "The namespace of the resource.",
+							Description: "The namespace of the resource. The provider namespace is used when it is not set.",
 							Optional:    true,
+							Computed:    true,
 							ForceNew:    true,
 						},
 					},
@@ -62,14 +66,25 @@ func resourceKubernetesEnv() *schema.Resource {
 				Description:  "Name of the container for which we are updating the environment variables.",
 				Optional:     true,
 				ValidateFunc: validation.StringIsNotWhiteSpace,
-				ExactlyOneOf: []string{"container", "init_container"},
+				ExactlyOneOf: []string{"container", "init_container", "containers"},
 			},
 			"init_container": {
 				Type:         schema.TypeString,
 				Description:  "Name of the initContainer for which we are updating the environment variables.",
 				Optional:     true,
 				ValidateFunc: validation.StringIsNotWhiteSpace,
-				ExactlyOneOf: []string{"container", "init_container"},
+				ExactlyOneOf: []string{"container", "init_container", "containers"},
+			},
+			"containers": {
+				Type:         schema.TypeList,
+				Description:  "Names of the containers or initContainers for which we are updating the environment variables. `*` selects all the containers, initContainers excluded.",
+				Optional:     true,
+				MinItems:     1,
+				ExactlyOneOf: []string{"container", "init_container", "containers"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
 			},
 			"api_version": {
 				Type:        schema.TypeString,
@@ -82,10 +97,12 @@ func resourceKubernetesEnv() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"CronJob", "Deployment", "Pod", "DaemonSet", "replicationcontroller", "StatefulSet", "ReplicaSet"}, true),
 				Required:     true,
 			},
+			"env_from": envFromSchema(),
 			"env": {
-				Type:        schema.TypeList,
-				Description: "List of custom values used to represent environment variables",
-				Required:    true,
+				Type:         schema.TypeList,
+				Description:  "List of custom values used to represent environment variables",
+				Optional:     true,
+				AtLeastOneOf: []string{"env", "env_from"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -223,8 +240,19 @@ func resourceKubernetesEnv() *schema.Resource {
 	}
 }
 
+// envFromSchema returns the schema of the `env_from` sources, shared with the containers of the workload resources.
+func envFromSchema() *schema.Schema {
+	s := containerFields(true)["env_from"]
+	s.Description = "List of sources to populate environment variables in the container. The list is managed as a whole: it replaces all the sources of the container."
+	s.AtLeastOneOf = []string{"env", "env_from"}
+	return s
+}
+
 func resourceKubernetesEnvCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata, err := expandTargetMetadata(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	d.SetId(buildIdWithVersionKind(metadata,
 		d.Get("api_version").(string),
 		d.Get("kind").(string)))
@@ -241,7 +269,7 @@ func resourceKubernetesEnvRead(ctx context.Context, d *schema.ResourceData, m in
 		return diag.FromErr(err)
 	}
 
-	gvk, name, namespace, err := util.ParseResourceID(d.Id())
+	gvk, name, namespace, err := util.ParseResourceID(d.Id(), "")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -265,7 +293,7 @@ func resourceKubernetesEnvRead(ctx context.Context, d *schema.ResourceData, m in
 	var r dynamic.ResourceInterface
 	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
 		if namespace == "" {
-			namespace = "default"
+			namespace = util.DefaultNamespace
 		}
 		r = conn.Resource(mapping.Resource).Namespace(namespace)
 	} else {
@@ -285,79 +313,194 @@ func resourceKubernetesEnvRead(ctx context.Context, d *schema.ResourceData, m in
 		return diag.FromErr(err)
 	}
 
+	kind := d.Get("kind").(string)
+	containers, initContainers, err := envTargetContainers(d, res)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	// store names of environment variables into map
 	configuredEnvs := make(map[string]interface{})
 	envList := d.Get("env").([]interface{})
 	for _, e := range envList {
 		configuredEnvs[e.(map[string]interface{})["name"].(string)] = ""
 	}
-
-	var container string
-	if c := d.Get("container").(string); c != "" {
-		container = c
-	} else {
-		container = d.Get("init_container").(string)
-	}
+	envFromList := d.Get("env_from").([]interface{})
 
 	// strip out envs not managed by Terraform
 	fieldManagerName := d.Get("field_manager").(string)
-	managedEnvs, err := getManagedEnvs(res.GetManagedFields(), fieldManagerName, d, res)
-	if err != nil {
-		return diag.FromErr(err)
+	var envs, envFroms []interface{}
+	targets := map[string][]string{"containers": containers, "initContainers": initContainers}
+	for _, field := range []string{"containers", "initContainers"} {
+		for _, container := range targets[field] {
+			managed, err := getManagedContainerFields(res.GetManagedFields(), fieldManagerName, kind, field, container)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			c, err := getResponseContainer(res, field, container, kind)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			managedEnvs, _ := managed["f:env"].(map[string]interface{})
+			responseEnvs, _ := c["env"].([]interface{})
+			env := []interface{}{}
+			for _, e := range responseEnvs {
+				envName := e.(map[string]interface{})["name"].(string)
+				_, managed := managedEnvs[fmt.Sprintf(`k:{"name":%q}`, envName)]
+				_, configured := configuredEnvs[envName]
+				if !managed && !configured {
+					continue
+				}
+				env = append(env, e)
+			}
+			envs = append(envs, flattenEnv(env))
+
+			// envFrom is an atomic list, owned as a whole
+			envFrom := []interface{}{}
+			if _, managed := managed["f:envFrom"]; managed || len(envFromList) > 0 {
+				var sources []corev1.EnvFromSource
+				if raw, ok := c["envFrom"]; ok {
+					b, err := json.Marshal(raw)
+					if err != nil {
+						return diag.FromErr(err)
+					}
+					if err := json.Unmarshal(b, &sources); err != nil {
+						return diag.FromErr(err)
+					}
+				}
+				envFrom = flattenContainerEnvFroms(sources)
+			}
+			envFroms = append(envFroms, envFrom)
+		}
 	}
 
-	responseEnvs, err := getResponseEnvs(res, container, d.Get("kind").(string))
+	d.Set("env", selectedContainerValue(envs, flattenEnv(toInterfaceSlice(expandEnv(envList)))))
+	envFromConfigured, err := expandContainerEnvFrom(envFromList)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	d.Set("env_from", selectedContainerValue(envFroms, flattenContainerEnvFroms(envFromConfigured)))
+	return nil
+}
 
-	env := []interface{}{}
-	for _, e := range responseEnvs {
-		envName := e.(map[string]interface{})["name"].(string)
-		_, managed := managedEnvs[fmt.Sprintf(`k:{"name":%q}`, envName)]
-		_, configured := configuredEnvs[envName]
-		if !managed && !configured {
-			continue
+// selectedContainerValue returns the value to store in state out of the values
+// read from each targeted container: the first one that differs from the
+// configured value, so that a container out of sync shows as a change.
+func selectedContainerValue(values []interface{}, configured []interface{}) interface{} {
+	if len(values) == 0 {
+		return configured
+	}
+	for _, v := range values {
+		if !patchEqual(v, configured) {
+			return v
 		}
-		env = append(env, e)
 	}
-
-	env = flattenEnv(env)
-	d.Set("env", env)
-	return nil
+	return values[0]
 }
 
-func getResponseEnvs(u *unstructured.Unstructured, containerName string, kind string) ([]interface{}, error) {
-	var containers []interface{}
-	var initContainers []interface{}
-
-	initContainers, _, _ = unstructured.NestedSlice(u.Object, "spec", "template", "spec", "initContainers")
-	if kind == "CronJob" {
-		initContainers, _, _ = unstructured.NestedSlice(u.Object, "spec", "jobTemplate", "spec", "template", "spec", "initContainers")
+func toInterfaceSlice(in []map[string]interface{}) []interface{} {
+	out := make([]interface{}, len(in))
+	for i, v := range in {
+		out[i] = v
 	}
+	return out
+}
 
-	containers, _, _ = unstructured.NestedSlice(u.Object, "spec", "template", "spec", "containers")
-	if kind == "CronJob" {
-		containers, _, _ = unstructured.NestedSlice(u.Object, "spec", "jobTemplate", "spec", "template", "spec", "containers")
+// podTemplateSpecPath returns the path of the pod spec in a resource of the given kind.
+func podTemplateSpecPath(kind string) []string {
+	switch kind {
+	case "Pod":
+		return []string{"spec"}
+	case "CronJob":
+		// CronJob nests under an additional jobTemplate field
+		return []string{"spec", "jobTemplate", "spec", "template", "spec"}
 	}
+	return []string{"spec", "template", "spec"}
+}
 
-	containers = append(containers, initContainers...)
+// envTargetContainers returns the names of the containers and initContainers
+// of u targeted by d.
+func envTargetContainers(d *schema.ResourceData, u *unstructured.Unstructured) ([]string, []string, error) {
+	if c := d.Get("container").(string); c != "" {
+		return []string{c}, nil, nil
+	}
+	if c := d.Get("init_container").(string); c != "" {
+		return nil, []string{c}, nil
+	}
+
+	path := podTemplateSpecPath(d.Get("kind").(string))
+	names := func(field string) map[string]bool {
+		out := map[string]bool{}
+		list, _, _ := unstructured.NestedSlice(u.Object, append(path, field)...)
+		for _, c := range list {
+			if m, ok := c.(map[string]interface{}); ok {
+				if n, ok := m["name"].(string); ok {
+					out[n] = true
+				}
+			}
+		}
+		return out
+	}
+	existing := names("containers")
+	existingInit := names("initContainers")
+
+	var containers, initContainers []string
+	seen := map[string]bool{}
+	for _, n := range expandStringSlice(d.Get("containers").([]interface{})) {
+		var add []string
+		switch {
+		case n == "*":
+			for c := range existing {
+				add = append(add, c)
+			}
+			sort.Strings(add)
+		case existing[n]:
+			add = []string{n}
+		case existingInit[n]:
+			if !seen[n] {
+				seen[n] = true
+				initContainers = append(initContainers, n)
+			}
+			continue
+		default:
+			return nil, nil, fmt.Errorf("could not find container with name %q", n)
+		}
+		for _, c := range add {
+			if !seen[c] {
+				seen[c] = true
+				containers = append(containers, c)
+			}
+		}
+	}
+	return containers, initContainers, nil
+}
 
+// getResponseContainer returns the container named containerName in the
+// containers or initContainers field of u.
+func getResponseContainer(u *unstructured.Unstructured, field, containerName string, kind string) (map[string]interface{}, error) {
+	containers, _, _ := unstructured.NestedSlice(u.Object, append(podTemplateSpecPath(kind), field)...)
 	for _, c := range containers {
-		container := c.(map[string]interface{})
-		if container["name"].(string) == containerName {
-			return container["env"].([]interface{}), nil
+		container, ok := c.(map[string]interface{})
+		if ok && container["name"] == containerName {
+			return container, nil
 		}
 	}
 	return nil, fmt.Errorf("could not find container with name %q", containerName)
 }
 
-// getManagedEnvs reads the field manager metadata to discover which environment variables we're managing
-func getManagedEnvs(managedFields []v1.ManagedFieldsEntry, manager string, d *schema.ResourceData, u *unstructured.Unstructured) (map[string]interface{}, error) {
-	var envs map[string]interface{}
-	kind := d.Get("kind").(string)
+// getManagedContainerFields reads the field manager metadata to discover which
+// fields of a container we're managing
+func getManagedContainerFields(managedFields []v1.ManagedFieldsEntry, manager, kind, field, containerName string) (map[string]interface{}, error) {
+	path := []string{}
+	for _, p := range append(podTemplateSpecPath(kind), field, fmt.Sprintf(`k:{"name":%q}`, containerName)) {
+		if !strings.HasPrefix(p, "k:") {
+			p = "f:" + p
+		}
+		path = append(path, p)
+	}
 	for _, m := range managedFields {
-		if m.Manager != manager {
+		if m.Manager != manager || m.Operation != v1.ManagedFieldsOperationApply {
 			continue
 		}
 		var mm map[string]interface{}
@@ -365,29 +508,10 @@ func getManagedEnvs(managedFields []v1.ManagedFieldsEntry, manager string, d *sc
 		if err != nil {
 			return nil, err
 		}
-
-		spec, _, err := unstructured.NestedMap(u.Object, "f:spec", "f:template", "f:spec")
-		if kind == "CronJob" {
-			spec, _, err = unstructured.NestedMap(u.Object, "f:spec", "f:jobTemplate", "f:spec", "f:template", "f:spec")
-		}
-		if err == nil {
-			return nil, err
-		}
-
-		fieldManagerKey := "f:containers"
-		containerName := d.Get("container").(string)
-		if v := d.Get("init_container").(string); v != "" {
-			containerName = v
-			fieldManagerKey = "f:initContainers"
-		}
-		containers := spec[fieldManagerKey].(map[string]interface{})
-		containerKey := fmt.Sprintf(`k:{"name":%q}`, containerName)
-		k := containers[containerKey].(map[string]interface{})
-		if e, ok := k["f:env"].(map[string]interface{}); ok {
-			envs = e
-		}
+		container, _, _ := unstructured.NestedMap(mm, path...)
+		return container, nil
 	}
-	return envs, nil
+	return nil, nil
 }
 
 func resourceKubernetesEnvUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -426,7 +550,7 @@ func resourceKubernetesEnvUpdate(ctx context.Context, d *schema.ResourceData, m
 	namespacedResource := mapping.Scope.Name() == meta.RESTScopeNameNamespace
 	if namespacedResource {
 		if namespace == "" {
-			namespace = "default"
+			namespace = util.DefaultNamespace
 		}
 		r = conn.Resource(mapping.Resource).Namespace(namespace)
 	} else {
@@ -434,7 +558,7 @@ func resourceKubernetesEnvUpdate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	// check the resource exists before we try and patch it
-	_, err = r.Get(ctx, name, v1.GetOptions{})
+	res, err := r.Get(ctx, name, v1.GetOptions{})
 	if err != nil {
 		if d.Id() == "" {
 			// if we are deleting then there is nothing to do
@@ -451,49 +575,49 @@ func resourceKubernetesEnvUpdate(ctx context.Context, d *schema.ResourceData, m
 		patchmeta["namespace"] = namespace
 	}
 
+	containers, initContainers, err := envTargetContainers(d, res)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	env := d.Get("env")
 	env = expandEnv(env.([]interface{}))
 	if d.Id() == "" {
 		env = []map[string]interface{}{}
 	}
-
-	containersField := "containers"
-	containerName := d.Get("container")
-	if v := d.Get("init_container").(string); v != "" {
-		containersField = "initContainers"
-		containerName = v
-	}
-
-	containerSpec := map[string]interface{}{
-		"name": containerName,
-		"env":  env,
+	var envFrom interface{}
+	if v := d.Get("env_from").([]interface{}); len(v) > 0 && d.Id() != "" {
+		sources, err := expandContainerEnvFrom(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		envFrom = sources
 	}
 
-	spec := map[string]interface{}{
-		"template": map[string]interface{}{
-			"spec": map[string]interface{}{
-				containersField: []interface{}{
-					containerSpec,
-				},
-			},
-		},
+	podSpec := map[string]interface{}{}
+	targets := map[string][]string{"containers": containers, "initContainers": initContainers}
+	for field, names := range targets {
+		if len(names) == 0 {
+			continue
+		}
+		list := []interface{}{}
+		for _, n := range names {
+			containerSpec := map[string]interface{}{
+				"name": n,
+				"env":  env,
+			}
+			if envFrom != nil {
+				containerSpec["envFrom"] = envFrom
+			}
+			list = append(list, containerSpec)
+		}
+		podSpec[field] = list
 	}
 
-	if kind == "CronJob" {
-		// CronJob nests under an additional jobTemplate field
-		spec = map[string]interface{}{
-			"jobTemplate": map[string]interface{}{
-				"spec": map[string]interface{}{
-					"template": map[string]interface{}{
-						"spec": map[string]interface{}{
-							containersField: []interface{}{
-								containerSpec,
-							},
-						},
-					},
-				},
-			},
-		}
+	var spec interface{} = podSpec
+	path := podTemplateSpecPath(kind)
+	for i := len(path) - 1; i > 0; i-- {
+		spec = map[string]interface{}{path[i]: spec}
 	}
 
 	patchObj := map[string]interface{}{
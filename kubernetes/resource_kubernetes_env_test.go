@@ -6,15 +6,18 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/utils/ptr"
 )
 
@@ -248,6 +251,108 @@ func TestAccKubernetesEnv_CronJob_initContainer(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesEnv_Deployment_allContainersEnvFrom(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	namespace := "default"
+	configMapName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "kubernetes_env.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if err := createEnv(t, name, namespace); err != nil {
+				t.Fatal(err)
+			}
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			err := confirmExistingEnvs(name, namespace)
+			if err != nil {
+				return err
+			}
+			return destroyEnv(name, namespace)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesEnv_Deployment_allContainersEnvFrom(configMapName, name, namespace),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "containers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "containers.0", "*"),
+					resource.TestCheckResourceAttr(resourceName, "env.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "env.0.name", "NGINX_HOST"),
+					resource.TestCheckResourceAttr(resourceName, "env_from.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "env_from.0.config_map_ref.0.name", configMapName),
+					resource.TestCheckResourceAttr(resourceName, "env_from.0.prefix", "APP_"),
+				),
+			},
+		},
+	})
+}
+
+func TestEnvTargetContainers(t *testing.T) {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "app"},
+						map[string]interface{}{"name": "sidecar"},
+					},
+					"initContainers": []interface{}{
+						map[string]interface{}{"name": "init"},
+					},
+				},
+			},
+		},
+	}}
+
+	cases := map[string]struct {
+		containers     []interface{}
+		expected       []string
+		expectedInit   []string
+		expectingError bool
+	}{
+		"all": {
+			containers: []interface{}{"*"},
+			expected:   []string{"app", "sidecar"},
+		},
+		"names": {
+			containers:   []interface{}{"sidecar", "init"},
+			expected:     []string{"sidecar"},
+			expectedInit: []string{"init"},
+		},
+		"duplicates": {
+			containers: []interface{}{"app", "*"},
+			expected:   []string{"app", "sidecar"},
+		},
+		"missing": {
+			containers:     []interface{}{"missing"},
+			expectingError: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceKubernetesEnv().Schema, map[string]interface{}{
+				"kind":       "Deployment",
+				"containers": tc.containers,
+			})
+			containers, initContainers, err := envTargetContainers(d, u)
+			if tc.expectingError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(containers, tc.expected) || !reflect.DeepEqual(initContainers, tc.expectedInit) {
+				t.Fatalf("expected %v and %v, got %v and %v", tc.expected, tc.expectedInit, containers, initContainers)
+			}
+		})
+	}
+}
+
 func createInitContainerEnv(t *testing.T, name, namespace string) error {
 	conn, err := testAccProvider.Meta().(providerMetadata).MainClientset()
 	if err != nil {
@@ -1050,3 +1155,36 @@ resource "kubernetes_env" "demo" {
 }
 	`, secretName, configMapName, name, namespace)
 }
+
+func testAccKubernetesEnv_Deployment_allContainersEnvFrom(configMapName, name, namespace string) string {
+	return fmt.Sprintf(`resource "kubernetes_config_map_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  data = {
+    one = "first"
+  }
+}
+
+resource "kubernetes_env" "test" {
+  api_version = "apps/v1"
+  kind        = "Deployment"
+  metadata {
+    name      = "%s"
+    namespace = "%s"
+  }
+  containers = ["*"]
+  env {
+    name  = "NGINX_HOST"
+    value = "foobar.com"
+  }
+  env_from {
+    config_map_ref {
+      name = kubernetes_config_map_v1.test.metadata.0.name
+    }
+    prefix = "APP_"
+  }
+}
+`, configMapName, name, namespace)
+}
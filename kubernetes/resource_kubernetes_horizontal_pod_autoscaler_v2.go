@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,7 +19,7 @@ import (
 )
 
 func resourceKubernetesHorizontalPodAutoscalerV2() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "Horizontal Pod Autoscaler automatically scales the number of pods in a replication controller, deployment or replica set based on observed CPU utilization.",
 		CreateContext: resourceKubernetesHorizontalPodAutoscalerV2Create,
 		ReadContext:   resourceKubernetesHorizontalPodAutoscalerV2Read,
@@ -28,7 +29,7 @@ func resourceKubernetesHorizontalPodAutoscalerV2() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: horizontalPodAutoscalerSchemaV2(),
-	}
+	}, resourceKubernetesHorizontalPodAutoscalerV2DryRun)
 }
 
 func resourceKubernetesHorizontalPodAutoscalerV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -37,18 +38,12 @@ func resourceKubernetesHorizontalPodAutoscalerV2Create(ctx context.Context, d *s
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandHorizontalPodAutoscalerV2Spec(d.Get("spec").([]interface{}))
+	hpa, err := expandHorizontalPodAutoscalerV2(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	hpa := autoscalingv2.HorizontalPodAutoscaler{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
 	log.Printf("[INFO] Creating new horizontal pod autoscaler: %#v", hpa)
-	out, err := conn.AutoscalingV2().HorizontalPodAutoscalers(metadata.Namespace).Create(ctx, &hpa, metav1.CreateOptions{})
+	out, err := createOrApply(ctx, meta, "autoscaling/v2", "HorizontalPodAutoscaler", hpa, conn.AutoscalingV2().HorizontalPodAutoscalers(hpa.Namespace).Create)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -59,6 +54,18 @@ func resourceKubernetesHorizontalPodAutoscalerV2Create(ctx context.Context, d *s
 	return resourceKubernetesHorizontalPodAutoscalerV2Read(ctx, d, meta)
 }
 
+// expandHorizontalPodAutoscalerV2 builds the horizontal pod autoscaler of d.
+func expandHorizontalPodAutoscalerV2(d *schema.ResourceData, meta interface{}) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	spec, err := expandHorizontalPodAutoscalerV2Spec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesHorizontalPodAutoscalerV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesHorizontalPodAutoscalerV2Exists(ctx, d, meta)
 	if err != nil {
@@ -100,6 +107,10 @@ func resourceKubernetesHorizontalPodAutoscalerV2Read(ctx context.Context, d *sch
 }
 
 func resourceKubernetesHorizontalPodAutoscalerV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesHorizontalPodAutoscalerV2Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -110,7 +121,7 @@ func resourceKubernetesHorizontalPodAutoscalerV2Update(ctx context.Context, d *s
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("spec") {
 		diffOps := patchHorizontalPodAutoscalerV2Spec("spec.0.", "/spec", d)
 		ops = append(ops, diffOps...)
@@ -130,7 +141,56 @@ func resourceKubernetesHorizontalPodAutoscalerV2Update(ctx context.Context, d *s
 	return resourceKubernetesHorizontalPodAutoscalerV2Read(ctx, d, meta)
 }
 
+// horizontalPodAutoscalerV2ComputedFields are the fields left out of a
+// server-side apply update when their attribute is not configured.
+var horizontalPodAutoscalerV2ComputedFields = map[string][]string{
+	"spec.0.behavior": {"spec", "behavior"},
+	"spec.0.metric":   {"spec", "metrics"},
+}
+
+// resourceKubernetesHorizontalPodAutoscalerV2Apply updates a horizontal pod
+// autoscaler with server-side apply.
+func resourceKubernetesHorizontalPodAutoscalerV2Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	hpa, err := expandHorizontalPodAutoscalerV2(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	out, err := applyUpdate(ctx, d, meta, "autoscaling/v2", "HorizontalPodAutoscaler", hpa, horizontalPodAutoscalerV2ComputedFields)
+	if err != nil {
+		return diag.Errorf("Failed to update horizontal pod autoscaler: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated horizontal pod autoscaler: %#v", out)
+
+	return resourceKubernetesHorizontalPodAutoscalerV2Read(ctx, d, meta)
+}
+
+func resourceKubernetesHorizontalPodAutoscalerV2DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	hpa, err := expandHorizontalPodAutoscalerV2(d, meta)
+	if err != nil {
+		return err
+	}
+	client := conn.AutoscalingV2().HorizontalPodAutoscalers(hpa.Namespace)
+	switch {
+	case !update:
+		_, err = client.Create(ctx, hpa, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "autoscaling/v2", "HorizontalPodAutoscaler", hpa, horizontalPodAutoscalerV2ComputedFields)
+	default:
+		err = dryRunPatch(ctx, hpa, client.Patch)
+	}
+	return err
+}
+
 func resourceKubernetesHorizontalPodAutoscalerV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "autoscaling/v2", "HorizontalPodAutoscaler")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -141,7 +201,7 @@ func resourceKubernetesHorizontalPodAutoscalerV2Delete(ctx context.Context, d *s
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Deleting horizontal pod autoscaler: %#v", name)
-	err = conn.AutoscalingV2().HorizontalPodAutoscalers(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.AutoscalingV2().HorizontalPodAutoscalers(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
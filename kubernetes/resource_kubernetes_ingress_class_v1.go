@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,7 +20,7 @@ import (
 )
 
 func resourceKubernetesIngressClassV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "Ingresses can be implemented by different controllers, often with different configuration. Each Ingress should specify a class, a reference to an IngressClass resource that contains additional configuration including the name of the controller that should implement the class.",
 		CreateContext: resourceKubernetesIngressClassV1Create,
 		ReadContext:   resourceKubernetesIngressClassV1Read,
@@ -29,7 +30,7 @@ func resourceKubernetesIngressClassV1() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: resourceKubernetesIngressClassV1Schema(),
-	}
+	}, resourceKubernetesIngressClassV1DryRun)
 }
 
 func resourceKubernetesIngressClassV1Schema() map[string]*schema.Schema {
@@ -38,7 +39,9 @@ func resourceKubernetesIngressClassV1Schema() map[string]*schema.Schema {
 	docIngressClassSpecParametes := corev1.TypedLocalObjectReference{}.SwaggerDoc()
 
 	return map[string]*schema.Schema{
-		"metadata": metadataSchema("ingress_class_v1", true),
+		"metadata":         metadataSchema("ingress_class_v1", true),
+		"delete_options":   deleteOptionsSchema(),
+		"destroy_behavior": destroyBehaviorSchema(),
 		"spec": {
 			Type:        schema.TypeList,
 			Description: docIngressClass["spec"],
@@ -99,13 +102,9 @@ func resourceKubernetesIngressClassV1Create(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	ing := &networking.IngressClass{
-		Spec: expandIngressClassV1Spec(d.Get("spec").([]interface{})),
-	}
-	ing.ObjectMeta = metadata
+	ing := expandIngressClassV1(d, meta)
 	log.Printf("[INFO] Creating new Ingress Class: %#v", ing)
-	out, err := conn.NetworkingV1().IngressClasses().Create(ctx, ing, metav1.CreateOptions{})
+	out, err := createOrApply(ctx, meta, "networking.k8s.io/v1", "IngressClass", ing, conn.NetworkingV1().IngressClasses().Create)
 	if err != nil {
 		return diag.Errorf("Failed to create Ingress Class '%s' because: %s", buildId(ing.ObjectMeta), err)
 	}
@@ -115,6 +114,14 @@ func resourceKubernetesIngressClassV1Create(ctx context.Context, d *schema.Resou
 	return diag.Diagnostics{}
 }
 
+// expandIngressClassV1 builds the ingress class of d.
+func expandIngressClassV1(d *schema.ResourceData, meta interface{}) *networking.IngressClass {
+	return &networking.IngressClass{
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+		Spec:       expandIngressClassV1Spec(d.Get("spec").([]interface{})),
+	}
+}
+
 func resourceKubernetesIngressClassV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesIngressClassV1Exists(ctx, d, meta)
 	if err != nil {
@@ -154,12 +161,16 @@ func resourceKubernetesIngressClassV1Read(ctx context.Context, d *schema.Resourc
 }
 
 func resourceKubernetesIngressClassV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesIngressClassV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
 	spec := expandIngressClassV1Spec(d.Get("spec").([]interface{}))
 
 	if metadata.Namespace == "" {
@@ -180,7 +191,42 @@ func resourceKubernetesIngressClassV1Update(ctx context.Context, d *schema.Resou
 	return resourceKubernetesIngressClassV1Read(ctx, d, meta)
 }
 
+// resourceKubernetesIngressClassV1Apply updates an ingress class with server-side apply.
+func resourceKubernetesIngressClassV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ingressClass := expandIngressClassV1(d, meta)
+	out, err := applyUpdate(ctx, d, meta, "networking.k8s.io/v1", "IngressClass", ingressClass, nil)
+	if err != nil {
+		return diag.Errorf("Failed to update Ingress Class %s because: %s", buildId(ingressClass.ObjectMeta), err)
+	}
+	log.Printf("[INFO] Submitted updated Ingress Class: %#v", out)
+
+	return resourceKubernetesIngressClassV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesIngressClassV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	ingressClass := expandIngressClassV1(d, meta)
+	client := conn.NetworkingV1().IngressClasses()
+	switch {
+	case !update:
+		_, err = client.Create(ctx, ingressClass, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "networking.k8s.io/v1", "IngressClass", ingressClass, nil)
+	default:
+		_, err = client.Update(ctx, ingressClass, metav1.UpdateOptions{DryRun: dryRunOptions})
+	}
+	return err
+}
+
 func resourceKubernetesIngressClassV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "networking.k8s.io/v1", "IngressClass")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -189,7 +235,7 @@ func resourceKubernetesIngressClassV1Delete(ctx context.Context, d *schema.Resou
 	name := d.Id()
 
 	log.Printf("[INFO] Deleting Ingress Class: %#v", name)
-	err = conn.NetworkingV1().IngressClasses().Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.NetworkingV1().IngressClasses().Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		return diag.Errorf("Failed to delete Ingress Class %s because: %s", d.Id(), err)
 	}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	networking "k8s.io/api/networking/v1"
 
@@ -20,7 +21,7 @@ import (
 )
 
 func resourceKubernetesIngressV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "Ingress is a collection of rules that allow inbound connections to reach the endpoints defined by a backend. An Ingress can be configured to give services externally-reachable urls, load balance traffic, terminate SSL, offer name based virtual hosting etc.",
 		CreateContext: resourceKubernetesIngressV1Create,
 		ReadContext:   resourceKubernetesIngressV1Read,
@@ -34,7 +35,7 @@ func resourceKubernetesIngressV1() *schema.Resource {
 			Create: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
-	}
+	}, resourceKubernetesIngressV1DryRun)
 }
 
 func resourceKubernetesIngressV1Schema() map[string]*schema.Schema {
@@ -46,7 +47,9 @@ func resourceKubernetesIngressV1Schema() map[string]*schema.Schema {
 	docIngressSpec := networking.IngressSpec{}.SwaggerDoc()
 
 	return map[string]*schema.Schema{
-		"metadata": namespacedMetadataSchema("ingress", true),
+		"metadata":         namespacedMetadataSchema("ingress", true),
+		"delete_options":   deleteOptionsSchema(),
+		"destroy_behavior": destroyBehaviorSchema(),
 		"spec": {
 			Type:        schema.TypeList,
 			Description: docIngress["spec"],
@@ -180,13 +183,10 @@ func resourceKubernetesIngressV1Create(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	ing := &networking.Ingress{
-		Spec: expandIngressV1Spec(d.Get("spec").([]interface{})),
-	}
-	ing.ObjectMeta = metadata
+	ing := expandIngressV1(d, meta)
+	metadata := ing.ObjectMeta
 	log.Printf("[INFO] Creating new ingress: %#v", ing)
-	out, err := conn.NetworkingV1().Ingresses(metadata.Namespace).Create(ctx, ing, metav1.CreateOptions{})
+	out, err := createOrApply(ctx, meta, "networking.k8s.io/v1", "Ingress", ing, conn.NetworkingV1().Ingresses(metadata.Namespace).Create)
 	if err != nil {
 		return diag.Errorf("Failed to create Ingress '%s' because: %s", buildId(ing.ObjectMeta), err)
 	}
@@ -227,6 +227,14 @@ func resourceKubernetesIngressV1Create(ctx context.Context, d *schema.ResourceDa
 	return diag.Diagnostics{}
 }
 
+// expandIngressV1 builds the ingress of d.
+func expandIngressV1(d *schema.ResourceData, meta interface{}) *networking.Ingress {
+	return &networking.Ingress{
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+		Spec:       expandIngressV1Spec(d.Get("spec").([]interface{})),
+	}
+}
+
 func resourceKubernetesIngressV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesIngressV1Exists(ctx, d, meta)
 	if err != nil {
@@ -278,6 +286,10 @@ func resourceKubernetesIngressV1Read(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceKubernetesIngressV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesIngressV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -288,13 +300,9 @@ func resourceKubernetesIngressV1Update(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
 	spec := expandIngressV1Spec(d.Get("spec").([]interface{}))
 
-	if metadata.Namespace == "" {
-		metadata.Namespace = "default"
-	}
-
 	ingress := &networking.Ingress{
 		ObjectMeta: metadata,
 		Spec:       spec,
@@ -309,7 +317,48 @@ func resourceKubernetesIngressV1Update(ctx context.Context, d *schema.ResourceDa
 	return resourceKubernetesIngressV1Read(ctx, d, meta)
 }
 
+// ingressV1ComputedFields are the fields left out of a server-side apply
+// update when their attribute is not configured.
+var ingressV1ComputedFields = map[string][]string{
+	"spec.0.ingress_class_name": {"spec", "ingressClassName"},
+}
+
+// resourceKubernetesIngressV1Apply updates an ingress with server-side apply.
+func resourceKubernetesIngressV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ingress := expandIngressV1(d, meta)
+	out, err := applyUpdate(ctx, d, meta, "networking.k8s.io/v1", "Ingress", ingress, ingressV1ComputedFields)
+	if err != nil {
+		return diag.Errorf("Failed to update Ingress %s because: %s", buildId(ingress.ObjectMeta), err)
+	}
+	log.Printf("[INFO] Submitted updated ingress: %#v", out)
+
+	return resourceKubernetesIngressV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesIngressV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	ingress := expandIngressV1(d, meta)
+	client := conn.NetworkingV1().Ingresses(ingress.Namespace)
+	switch {
+	case !update:
+		_, err = client.Create(ctx, ingress, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "networking.k8s.io/v1", "Ingress", ingress, ingressV1ComputedFields)
+	default:
+		_, err = client.Update(ctx, ingress, metav1.UpdateOptions{DryRun: dryRunOptions})
+	}
+	return err
+}
+
 func resourceKubernetesIngressV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "networking.k8s.io/v1", "Ingress")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -321,7 +370,7 @@ func resourceKubernetesIngressV1Delete(ctx context.Context, d *schema.ResourceDa
 	}
 
 	log.Printf("[INFO] Deleting ingress: %#v", name)
-	err = conn.NetworkingV1().Ingresses(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.NetworkingV1().Ingresses(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		return diag.Errorf("Failed to delete Ingress %s because: %s", d.Id(), err)
 	}
@@ -49,7 +49,9 @@ func resourceKubernetesIngressSchemaV1() map[string]*schema.Schema {
 	docIngressSpec := networking.IngressSpec{}.SwaggerDoc()
 
 	return map[string]*schema.Schema{
-		"metadata": namespacedMetadataSchema("ingress", true),
+		"metadata":         namespacedMetadataSchema("ingress", true),
+		"delete_options":   deleteOptionsSchema(),
+		"destroy_behavior": destroyBehaviorSchema(),
 		"spec": {
 			Type:        schema.TypeList,
 			Description: docIngress["spec"],
@@ -171,7 +173,7 @@ func resourceKubernetesIngressV1Beta1Create(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
 	ing := &v1beta1.Ingress{
 		Spec: expandIngressSpec(d.Get("spec").([]interface{})),
 	}
@@ -279,13 +281,9 @@ func resourceKubernetesIngressV1Beta1Update(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
 	spec := expandIngressSpec(d.Get("spec").([]interface{}))
 
-	if metadata.Namespace == "" {
-		metadata.Namespace = "default"
-	}
-
 	ingress := &v1beta1.Ingress{
 		ObjectMeta: metadata,
 		Spec:       spec,
@@ -301,6 +299,10 @@ func resourceKubernetesIngressV1Beta1Update(ctx context.Context, d *schema.Resou
 }
 
 func resourceKubernetesIngressV1Beta1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "extensions/v1beta1", "Ingress")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -312,7 +314,7 @@ func resourceKubernetesIngressV1Beta1Delete(ctx context.Context, d *schema.Resou
 	}
 
 	log.Printf("[INFO] Deleting ingress: %#v", name)
-	err = conn.ExtensionsV1beta1().Ingresses(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.ExtensionsV1beta1().Ingresses(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		return diag.Errorf("Failed to delete Ingress %s because: %s", d.Id(), err)
 	}
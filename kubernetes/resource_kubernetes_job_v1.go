@@ -50,7 +50,10 @@ func resourceKubernetesJobV1() *schema.Resource {
 
 func resourceKubernetesJobV1Schema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
-		"metadata": jobMetadataSchema(),
+		"metadata":         jobMetadataSchema(),
+		"delete_options":   deleteOptionsSchema(),
+		"destroy_behavior": destroyBehaviorSchema(),
+		"wait_for_ready":   waitForReadySchema(),
 		"spec": {
 			Type:        schema.TypeList,
 			Description: "Spec of the job owned by the cluster",
@@ -75,7 +78,7 @@ func resourceKubernetesJobV1Create(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
 	spec, err := expandJobV1Spec(d.Get("spec").([]interface{}))
 	if err != nil {
 		return diag.FromErr(err)
@@ -104,11 +107,15 @@ func resourceKubernetesJobV1Create(ctx context.Context, d *schema.ResourceData,
 		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
 			retryUntilJobV1IsFinished(ctx, conn, namespace, name))
 		if err != nil {
-			return diag.FromErr(err)
+			return diag.FromErr(describeWorkloadFailure(ctx, conn, "Job", namespace, name, err))
 		}
 		return diag.Diagnostics{}
 	}
 
+	if diags := waitForObjectReady(ctx, d, meta, "batch/v1", "Job", out, schema.TimeoutCreate); diags.HasError() {
+		return diags
+	}
+
 	return resourceKubernetesJobV1Read(ctx, d, meta)
 }
 
@@ -173,7 +180,7 @@ func resourceKubernetesJobV1Update(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 
 	if d.HasChange("spec") {
 		specOps := patchJobV1Spec("/spec", "spec.0.", d)
@@ -199,13 +206,22 @@ func resourceKubernetesJobV1Update(ctx context.Context, d *schema.ResourceData,
 		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
 			retryUntilJobV1IsFinished(ctx, conn, namespace, name))
 		if err != nil {
-			return diag.FromErr(err)
+			return diag.FromErr(describeWorkloadFailure(ctx, conn, "Job", namespace, name, err))
 		}
 	}
+
+	if diags := waitForObjectReady(ctx, d, meta, "batch/v1", "Job", out, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
 	return resourceKubernetesJobV1Read(ctx, d, meta)
 }
 
 func resourceKubernetesJobV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "batch/v1", "Job")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -217,6 +233,11 @@ func resourceKubernetesJobV1Delete(ctx context.Context, d *schema.ResourceData,
 	}
 
 	log.Printf("[INFO] Deleting job: %#v", name)
+	deleteOptions := expandDeleteOptions(d, meta)
+	if deleteOptions.PropagationPolicy == nil {
+		// dependent pods are removed before the owner unless configured otherwise
+		deleteOptions.PropagationPolicy = &cascadeDeletePolicy
+	}
 	err = conn.BatchV1().Jobs(namespace).Delete(ctx, name, deleteOptions)
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
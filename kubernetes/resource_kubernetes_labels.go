@@ -45,9 +45,10 @@ func resourceKubernetesLabels() *schema.Resource {
 				ForceNew:    true,
 			},
 			"metadata": {
-				Type:     schema.TypeList,
-				Required: true,
-				MaxItems: 1,
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"metadata", "selector"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -58,13 +59,16 @@ func resourceKubernetesLabels() *schema.Resource {
 						},
 						"namespace": {
 							Type:        schema.TypeString,
-							Description: "The namespace of the resource.",
+							Description: "The namespace of the resource. The provider namespace is used when it is not set.",
 							Optional:    true,
+							Computed:    true,
 							ForceNew:    true,
 						},
 					},
 				},
 			},
+			"selector": resourceSelectorSchema(),
+			"targets":  selectorTargetsSchema(),
 			"labels": {
 				Type:        schema.TypeMap,
 				Description: "A map of labels to apply to the resource.",
@@ -87,10 +91,19 @@ func resourceKubernetesLabels() *schema.Resource {
 }
 
 func resourceKubernetesLabelsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	d.SetId(buildIdWithVersionKind(metadata,
-		d.Get("api_version").(string),
-		d.Get("kind").(string)))
+	if s, ok := d.GetOk("selector"); ok {
+		d.SetId(buildSelectorId(d.Get("api_version").(string),
+			d.Get("kind").(string),
+			expandResourceSelector(s.([]interface{}))))
+	} else {
+		metadata, err := expandTargetMetadata(d, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(buildIdWithVersionKind(metadata,
+			d.Get("api_version").(string),
+			d.Get("kind").(string)))
+	}
 	diag := resourceKubernetesLabelsUpdate(ctx, d, m)
 	if diag.HasError() {
 		d.SetId("")
@@ -99,12 +112,16 @@ func resourceKubernetesLabelsCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceKubernetesLabelsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if _, ok := d.GetOk("selector"); ok {
+		return resourceKubernetesLabelsReadSelected(ctx, d, m)
+	}
+
 	conn, err := m.(KubeClientsets).DynamicClient()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	gvk, name, namespace, err := util.ParseResourceID(d.Id())
+	gvk, name, namespace, err := util.ParseResourceID(d.Id(), "")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -128,7 +145,7 @@ func resourceKubernetesLabelsRead(ctx context.Context, d *schema.ResourceData, m
 	var r dynamic.ResourceInterface
 	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
 		if namespace == "" {
-			namespace = "default"
+			namespace = util.DefaultNamespace
 		}
 		r = conn.Resource(mapping.Resource).Namespace(namespace)
 	} else {
@@ -169,6 +186,32 @@ func resourceKubernetesLabelsRead(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+func resourceKubernetesLabelsReadSelected(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, objs, err := selectedObjects(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fieldManagerName := d.Get("field_manager").(string)
+	values := make([]map[string]string, len(objs))
+	managed := make([]map[string]interface{}, len(objs))
+	for i, obj := range objs {
+		values[i] = obj.GetLabels()
+		managed[i], err = getManagedLabels(obj.GetManagedFields(), fieldManagerName)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	configured := d.Get("labels").(map[string]interface{})
+	labels := selectedStateValues(configured, values, managed)
+	if len(missingTargets(d, objs)) > 0 {
+		labels = withoutConfiguredKeys(labels, configured)
+	}
+	d.Set("labels", labels)
+	return nil
+}
+
 // getManagedLabels reads the field manager metadata to discover which fields we're managing
 func getManagedLabels(managedFields []v1.ManagedFieldsEntry, manager string) (map[string]interface{}, error) {
 	var labels map[string]interface{}
@@ -192,6 +235,16 @@ func getManagedLabels(managedFields []v1.ManagedFieldsEntry, manager string) (ma
 }
 
 func resourceKubernetesLabelsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if _, ok := d.GetOk("selector"); ok {
+		diags := updateSelectedObjects(ctx, d, m, func(r dynamic.ResourceInterface, obj unstructured.Unstructured, release bool) diag.Diagnostics {
+			return applyLabels(ctx, d, r, obj.GetName(), obj.GetNamespace(), release)
+		})
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		return append(diags, resourceKubernetesLabelsRead(ctx, d, m)...)
+	}
+
 	conn, err := m.(KubeClientsets).DynamicClient()
 	if err != nil {
 		return diag.FromErr(err)
@@ -228,7 +281,7 @@ func resourceKubernetesLabelsUpdate(ctx context.Context, d *schema.ResourceData,
 	namespacedResource := mapping.Scope.Name() == meta.RESTScopeNameNamespace
 	if namespacedResource {
 		if namespace == "" {
-			namespace = "default"
+			namespace = util.DefaultNamespace
 		}
 		r = conn.Resource(mapping.Resource).Namespace(namespace)
 	} else {
@@ -246,9 +299,23 @@ func resourceKubernetesLabelsUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("The resource %q does not exist", name)
 	}
 
+	if !namespacedResource {
+		namespace = ""
+	}
+	diags := applyLabels(ctx, d, r, name, namespace, d.Id() == "")
+	if diags.HasError() || d.Id() == "" {
+		// don't try to read if we're deleting
+		return diags
+	}
+	return resourceKubernetesLabelsRead(ctx, d, m)
+}
+
+// applyLabels server-side applies the labels of d to a resource, or removes
+// them when release is set.
+func applyLabels(ctx context.Context, d *schema.ResourceData, r dynamic.ResourceInterface, name, namespace string, release bool) diag.Diagnostics {
 	// craft the patch to update the labels
 	labels := d.Get("labels")
-	if d.Id() == "" {
+	if release {
 		// if we're deleting then just we just patch
 		// with an empty labels map
 		labels = map[string]interface{}{}
@@ -258,12 +325,12 @@ func resourceKubernetesLabelsUpdate(ctx context.Context, d *schema.ResourceData,
 		"name":   name,
 		"labels": labels,
 	}
-	if namespacedResource {
+	if namespace != "" {
 		patchmeta["namespace"] = namespace
 	}
 	patchobj := map[string]interface{}{
-		"apiVersion": apiVersion,
-		"kind":       kind,
+		"apiVersion": d.Get("api_version"),
+		"kind":       d.Get("kind"),
 		"metadata":   patchmeta,
 	}
 	patch := unstructured.Unstructured{}
@@ -292,12 +359,7 @@ func resourceKubernetesLabelsUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 		return diag.FromErr(err)
 	}
-
-	if d.Id() == "" {
-		// don't try to read if we're deleting
-		return nil
-	}
-	return resourceKubernetesLabelsRead(ctx, d, m)
+	return nil
 }
 
 func resourceKubernetesLabelsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -79,6 +79,82 @@ func TestAccKubernetesLabels_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesLabels_selector(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	namespace := "default"
+	resourceName := "kubernetes_labels.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			createLabeledConfigMap(name+"-a", namespace, map[string]string{"selector": name})
+			createLabeledConfigMap(name+"-b", namespace, map[string]string{"selector": name})
+		},
+
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			destroyConfigMap(name+"-a", namespace)
+			return destroyConfigMap(name+"-b", namespace)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesLabels_selector(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "selector.0.label_selector", "selector="+name),
+					resource.TestCheckResourceAttr(resourceName, "labels.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "labels.test1", "one"),
+					testAccCheckConfigMapLabel(name+"-a", namespace, "test1", "one"),
+					testAccCheckConfigMapLabel(name+"-b", namespace, "test1", "one"),
+				),
+			},
+			{
+				// a ConfigMap that starts matching the selector is labelled on the next apply
+				PreConfig: func() {
+					createLabeledConfigMap(name+"-c", namespace, map[string]string{"selector": name})
+				},
+				Config: testAccKubernetesLabels_selector(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigMapLabel(name+"-c", namespace, "test1", "one"),
+					func(s *terraform.State) error {
+						return destroyConfigMap(name+"-c", namespace)
+					},
+				),
+			},
+		},
+	})
+}
+
+func createLabeledConfigMap(name, namespace string, labels map[string]string) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	cm := v1.ConfigMap{}
+	cm.SetName(name)
+	cm.SetNamespace(namespace)
+	cm.SetLabels(labels)
+	_, err = conn.CoreV1().ConfigMaps(namespace).Create(ctx, &cm, metav1.CreateOptions{})
+	return err
+}
+
+func testAccCheckConfigMapLabel(name, namespace, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		cm, err := conn.CoreV1().ConfigMaps(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if v := cm.GetLabels()[key]; v != value {
+			return fmt.Errorf("expected label %q of ConfigMap %q to be %q, got %q", key, name, value, v)
+		}
+		return nil
+	}
+}
+
 func createConfigMap(name, namespace string) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 	if err != nil {
@@ -146,3 +222,19 @@ func testAccKubernetesLabels_modified(name string) string {
 }
 `, name)
 }
+
+func testAccKubernetesLabels_selector(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_labels" "test" {
+  api_version = "v1"
+  kind        = "ConfigMap"
+  selector {
+    label_selector = "selector=%s"
+    namespace      = "default"
+  }
+  labels = {
+    "test1" = "one"
+  }
+  field_manager = "tftest"
+}
+`, name)
+}
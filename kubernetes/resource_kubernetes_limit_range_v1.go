@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,7 +18,7 @@ import (
 )
 
 func resourceKubernetesLimitRangeV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "Limit Range sets resource usage limits (e.g. memory, cpu, storage) for supported kinds of resources in a namespace. Read more in [the official docs](https://kubernetes.io/docs/concepts/policy/limit-range/).",
 		CreateContext: resourceKubernetesLimitRangeV1Create,
 		ReadContext:   resourceKubernetesLimitRangeV1Read,
@@ -28,7 +29,9 @@ func resourceKubernetesLimitRangeV1() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": namespacedMetadataSchema("limit range", true),
+			"metadata":         namespacedMetadataSchema("limit range", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"spec": {
 				Type:        schema.TypeList,
 				Description: "Spec defines the limits enforced. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status",
@@ -80,7 +83,7 @@ func resourceKubernetesLimitRangeV1() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, resourceKubernetesLimitRangeV1DryRun)
 }
 
 func resourceKubernetesLimitRangeV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -89,17 +92,12 @@ func resourceKubernetesLimitRangeV1Create(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandLimitRangeSpec(d.Get("spec").([]interface{}), d.IsNewResource())
+	limitRange, err := expandLimitRangeV1(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	limitRange := api.LimitRange{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
 	log.Printf("[INFO] Creating new limit range: %#v", limitRange)
-	out, err := conn.CoreV1().LimitRanges(metadata.Namespace).Create(ctx, &limitRange, metav1.CreateOptions{})
+	out, err := createOrApply(ctx, meta, "v1", "LimitRange", limitRange, conn.CoreV1().LimitRanges(limitRange.Namespace).Create)
 	if err != nil {
 		return diag.Errorf("Failed to create limit range: %s", err)
 	}
@@ -109,6 +107,18 @@ func resourceKubernetesLimitRangeV1Create(ctx context.Context, d *schema.Resourc
 	return resourceKubernetesLimitRangeV1Read(ctx, d, meta)
 }
 
+// expandLimitRangeV1 builds the limit range of d.
+func expandLimitRangeV1(d *schema.ResourceData, meta interface{}) (*api.LimitRange, error) {
+	spec, err := expandLimitRangeSpec(d.Get("spec").([]interface{}), d.IsNewResource())
+	if err != nil {
+		return nil, err
+	}
+	return &api.LimitRange{
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesLimitRangeV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesLimitRangeV1Exists(ctx, d, meta)
 	if err != nil {
@@ -148,6 +158,10 @@ func resourceKubernetesLimitRangeV1Read(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceKubernetesLimitRangeV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesLimitRangeV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -158,7 +172,7 @@ func resourceKubernetesLimitRangeV1Update(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("spec") {
 		spec, err := expandLimitRangeSpec(d.Get("spec").([]interface{}), d.IsNewResource())
 		if err != nil {
@@ -184,7 +198,48 @@ func resourceKubernetesLimitRangeV1Update(ctx context.Context, d *schema.Resourc
 	return resourceKubernetesLimitRangeV1Read(ctx, d, meta)
 }
 
+// resourceKubernetesLimitRangeV1Apply updates a limit range with server-side apply.
+func resourceKubernetesLimitRangeV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	limitRange, err := expandLimitRangeV1(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	out, err := applyUpdate(ctx, d, meta, "v1", "LimitRange", limitRange, nil)
+	if err != nil {
+		return diag.Errorf("Failed to update limit range: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated limit range: %#v", out)
+
+	return resourceKubernetesLimitRangeV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesLimitRangeV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	limitRange, err := expandLimitRangeV1(d, meta)
+	if err != nil {
+		return err
+	}
+	client := conn.CoreV1().LimitRanges(limitRange.Namespace)
+	switch {
+	case !update:
+		_, err = client.Create(ctx, limitRange, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "v1", "LimitRange", limitRange, nil)
+	default:
+		err = dryRunPatch(ctx, limitRange, client.Patch)
+	}
+	return err
+}
+
 func resourceKubernetesLimitRangeV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "v1", "LimitRange")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -196,7 +251,7 @@ func resourceKubernetesLimitRangeV1Delete(ctx context.Context, d *schema.Resourc
 	}
 
 	log.Printf("[INFO] Deleting limit range: %#v", name)
-	err = conn.CoreV1().LimitRanges(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().LimitRanges(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -34,7 +34,9 @@ func resourceKubernetesMutatingWebhookConfiguration() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": metadataSchema("mutating webhook configuration", true),
+			"metadata":         metadataSchema("mutating webhook configuration", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"webhook": {
 				Type:        schema.TypeList,
 				Description: apiDoc["webhooks"],
@@ -150,7 +152,7 @@ func resourceKubernetesMutatingWebhookConfigurationCreate(ctx context.Context, d
 	}
 
 	cfg := admissionregistrationv1.MutatingWebhookConfiguration{
-		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
 		Webhooks:   expandMutatingWebhooks(d.Get("webhook").([]interface{})),
 	}
 
@@ -238,7 +240,7 @@ func resourceKubernetesMutatingWebhookConfigurationUpdate(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 
 	if d.HasChange("webhook") {
 		op := &ReplaceOperation{
@@ -293,6 +295,10 @@ func resourceKubernetesMutatingWebhookConfigurationUpdate(ctx context.Context, d
 }
 
 func resourceKubernetesMutatingWebhookConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "admissionregistration.k8s.io/v1", "MutatingWebhookConfiguration")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -306,9 +312,9 @@ func resourceKubernetesMutatingWebhookConfigurationDelete(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 	if useadmissionregistrationv1beta1 {
-		err = conn.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Delete(ctx, name, metav1.DeleteOptions{})
+		err = conn.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Delete(ctx, name, expandDeleteOptions(d, meta))
 	} else {
-		err = conn.AdmissionregistrationV1().MutatingWebhookConfigurations().Delete(ctx, name, metav1.DeleteOptions{})
+		err = conn.AdmissionregistrationV1().MutatingWebhookConfigurations().Delete(ctx, name, expandDeleteOptions(d, meta))
 	}
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
@@ -32,7 +32,9 @@ func resourceKubernetesMutatingWebhookConfigurationV1() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": metadataSchema("mutating webhook configuration", true),
+			"metadata":         metadataSchema("mutating webhook configuration", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"webhook": {
 				Type:        schema.TypeList,
 				Description: apiDoc["webhooks"],
@@ -148,7 +150,7 @@ func resourceKubernetesMutatingWebhookConfigurationV1Create(ctx context.Context,
 	}
 
 	cfg := admissionregistrationv1.MutatingWebhookConfiguration{
-		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
 		Webhooks:   expandMutatingWebhooks(d.Get("webhook").([]interface{})),
 	}
 
@@ -207,7 +209,7 @@ func resourceKubernetesMutatingWebhookConfigurationV1Update(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 
 	if d.HasChange("webhook") {
 		op := &ReplaceOperation{
@@ -237,6 +239,10 @@ func resourceKubernetesMutatingWebhookConfigurationV1Update(ctx context.Context,
 }
 
 func resourceKubernetesMutatingWebhookConfigurationV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "admissionregistration.k8s.io/v1", "MutatingWebhookConfiguration")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -245,7 +251,7 @@ func resourceKubernetesMutatingWebhookConfigurationV1Delete(ctx context.Context,
 	name := d.Id()
 
 	log.Printf("[INFO] Deleting MutatingWebhookConfiguration: %#v", name)
-	err = conn.AdmissionregistrationV1().MutatingWebhookConfigurations().Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.AdmissionregistrationV1().MutatingWebhookConfigurations().Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -20,7 +21,7 @@ import (
 )
 
 func resourceKubernetesNamespaceV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "Kubernetes supports multiple virtual clusters backed by the same physical cluster. These virtual clusters are called namespaces. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces/.",
 		CreateContext: resourceKubernetesNamespaceV1Create,
 		ReadContext:   resourceKubernetesNamespaceV1Read,
@@ -31,7 +32,10 @@ func resourceKubernetesNamespaceV1() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": metadataSchema("namespace", true),
+			"metadata":         metadataSchema("namespace", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
+			"wait_for_ready":   waitForReadySchema(),
 			"wait_for_default_service_account": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -42,7 +46,7 @@ func resourceKubernetesNamespaceV1() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
-	}
+	}, resourceKubernetesNamespaceV1DryRun)
 }
 
 func resourceKubernetesNamespaceV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -51,12 +55,10 @@ func resourceKubernetesNamespaceV1Create(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	namespace := corev1.Namespace{
-		ObjectMeta: metadata,
-	}
+	namespace := expandNamespaceV1(d, meta)
+	metadata := namespace.ObjectMeta
 	log.Printf("[INFO] Creating new namespace: %#v", namespace)
-	out, err := conn.CoreV1().Namespaces().Create(ctx, &namespace, metav1.CreateOptions{})
+	out, err := createOrApply(ctx, meta, "v1", "Namespace", namespace, conn.CoreV1().Namespaces().Create)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -83,9 +85,21 @@ func resourceKubernetesNamespaceV1Create(ctx context.Context, d *schema.Resource
 			return diag.FromErr(err)
 		}
 	}
+
+	if diags := waitForObjectReady(ctx, d, meta, "v1", "Namespace", out, schema.TimeoutCreate); diags.HasError() {
+		return diags
+	}
+
 	return resourceKubernetesNamespaceV1Read(ctx, d, meta)
 }
 
+// expandNamespaceV1 builds the namespace of d.
+func expandNamespaceV1(d *schema.ResourceData, meta interface{}) *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+	}
+}
+
 func resourceKubernetesNamespaceV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesNamespaceV1Exists(ctx, d, meta)
 	if err != nil {
@@ -117,12 +131,16 @@ func resourceKubernetesNamespaceV1Read(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceKubernetesNamespaceV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesNamespaceV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	data, err := ops.MarshalJSON()
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
@@ -136,10 +154,52 @@ func resourceKubernetesNamespaceV1Update(ctx context.Context, d *schema.Resource
 	log.Printf("[INFO] Submitted updated namespace: %#v", out)
 	d.SetId(out.Name)
 
+	if diags := waitForObjectReady(ctx, d, meta, "v1", "Namespace", out, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
 	return resourceKubernetesNamespaceV1Read(ctx, d, meta)
 }
 
+// resourceKubernetesNamespaceV1Apply updates a namespace with server-side apply.
+func resourceKubernetesNamespaceV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	out, err := applyUpdate(ctx, d, meta, "v1", "Namespace", expandNamespaceV1(d, meta), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Submitted updated namespace: %#v", out)
+
+	if diags := waitForObjectReady(ctx, d, meta, "v1", "Namespace", out, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
+	return resourceKubernetesNamespaceV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesNamespaceV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	namespace := expandNamespaceV1(d, meta)
+	client := conn.CoreV1().Namespaces()
+	switch {
+	case !update:
+		_, err = client.Create(ctx, namespace, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "v1", "Namespace", namespace, nil)
+	default:
+		err = dryRunPatch(ctx, namespace, client.Patch)
+	}
+	return err
+}
+
 func resourceKubernetesNamespaceV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "v1", "Namespace")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -147,7 +207,7 @@ func resourceKubernetesNamespaceV1Delete(ctx context.Context, d *schema.Resource
 
 	name := d.Id()
 	log.Printf("[INFO] Deleting namespace: %#v", name)
-	err = conn.CoreV1().Namespaces().Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().Namespaces().Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
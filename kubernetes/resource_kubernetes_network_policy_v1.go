@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	networking "k8s.io/api/networking/v1"
@@ -37,7 +38,7 @@ var (
 )
 
 func resourceKubernetesNetworkPolicyV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "Kubernetes supports network policies to specify how groups of pods are allowed to communicate with each other and with other network endpoints. NetworkPolicy resources use labels to select pods and define rules which specify what traffic is allowed to the selected pods. Read more about network policies at https://kubernetes.io/docs/concepts/services-networking/network-policies/",
 		CreateContext: resourceKubernetesNetworkPolicyV1Create,
 		ReadContext:   resourceKubernetesNetworkPolicyV1Read,
@@ -48,7 +49,9 @@ func resourceKubernetesNetworkPolicyV1() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": namespacedMetadataSchema("network policy", true),
+			"metadata":         namespacedMetadataSchema("network policy", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"spec": {
 				Type:        schema.TypeList,
 				Description: networkPolicyV1SpecDoc,
@@ -246,7 +249,7 @@ func resourceKubernetesNetworkPolicyV1() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, resourceKubernetesNetworkPolicyV1DryRun)
 }
 
 func resourceKubernetesNetworkPolicyV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -255,18 +258,12 @@ func resourceKubernetesNetworkPolicyV1Create(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandNetworkPolicyV1Spec(d.Get("spec").([]interface{}))
+	svc, err := expandNetworkPolicyV1(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	svc := networking.NetworkPolicy{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
 	log.Printf("[INFO] Creating new network policy: %#v", svc)
-	out, err := conn.NetworkingV1().NetworkPolicies(metadata.Namespace).Create(ctx, &svc, metav1.CreateOptions{})
+	out, err := createOrApply(ctx, meta, "networking.k8s.io/v1", "NetworkPolicy", svc, conn.NetworkingV1().NetworkPolicies(svc.Namespace).Create)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -277,6 +274,18 @@ func resourceKubernetesNetworkPolicyV1Create(ctx context.Context, d *schema.Reso
 	return resourceKubernetesNetworkPolicyV1Read(ctx, d, meta)
 }
 
+// expandNetworkPolicyV1 builds the network policy of d.
+func expandNetworkPolicyV1(d *schema.ResourceData, meta interface{}) (*networking.NetworkPolicy, error) {
+	spec, err := expandNetworkPolicyV1Spec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &networking.NetworkPolicy{
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesNetworkPolicyV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesNetworkPolicyV1Exists(ctx, d, meta)
 	if err != nil {
@@ -318,6 +327,10 @@ func resourceKubernetesNetworkPolicyV1Read(ctx context.Context, d *schema.Resour
 }
 
 func resourceKubernetesNetworkPolicyV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesNetworkPolicyV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -328,7 +341,7 @@ func resourceKubernetesNetworkPolicyV1Update(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("spec") {
 		diffOps, err := patchNetworkPolicyV1Spec("spec.0.", "/spec", d)
 		if err != nil {
@@ -351,7 +364,48 @@ func resourceKubernetesNetworkPolicyV1Update(ctx context.Context, d *schema.Reso
 	return resourceKubernetesNetworkPolicyV1Read(ctx, d, meta)
 }
 
+// resourceKubernetesNetworkPolicyV1Apply updates a network policy with server-side apply.
+func resourceKubernetesNetworkPolicyV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	policy, err := expandNetworkPolicyV1(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	out, err := applyUpdate(ctx, d, meta, "networking.k8s.io/v1", "NetworkPolicy", policy, nil)
+	if err != nil {
+		return diag.Errorf("Failed to update network policy: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated network policy: %#v", out)
+
+	return resourceKubernetesNetworkPolicyV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesNetworkPolicyV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	policy, err := expandNetworkPolicyV1(d, meta)
+	if err != nil {
+		return err
+	}
+	client := conn.NetworkingV1().NetworkPolicies(policy.Namespace)
+	switch {
+	case !update:
+		_, err = client.Create(ctx, policy, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "networking.k8s.io/v1", "NetworkPolicy", policy, nil)
+	default:
+		err = dryRunPatch(ctx, policy, client.Patch)
+	}
+	return err
+}
+
 func resourceKubernetesNetworkPolicyV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "networking.k8s.io/v1", "NetworkPolicy")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -362,7 +416,7 @@ func resourceKubernetesNetworkPolicyV1Delete(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Deleting network policy: %#v", name)
-	err = conn.NetworkingV1().NetworkPolicies(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.NetworkingV1().NetworkPolicies(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
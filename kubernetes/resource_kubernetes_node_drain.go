@@ -0,0 +1,359 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	api "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+const mirrorPodAnnotation = "kubernetes.io/config.mirror"
+
+func resourceKubernetesNodeDrain() *schema.Resource {
+	return &schema.Resource{
+		Description:   "This resource cordons [nodes](https://kubernetes.io/docs/concepts/architecture/nodes/) and drains them, like `kubectl drain` does: the pods running on the nodes are evicted through the [Eviction API](https://kubernetes.io/docs/concepts/scheduling-eviction/api-eviction/), which respects their PodDisruptionBudgets. Destroying this resource uncordons the nodes when `uncordon_on_destroy` is set.",
+		CreateContext: resourceKubernetesNodeDrainCreate,
+		ReadContext:   resourceKubernetesNodeDrainRead,
+		UpdateContext: resourceKubernetesNodeDrainUpdate,
+		DeleteContext: resourceKubernetesNodeDrainDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"metadata": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"metadata", "node_selector"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the node to drain.",
+							Required:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+			"node_selector": {
+				Type:         schema.TypeString,
+				Description:  "A label selector of the nodes to drain, e.g. `node.kubernetes.io/pool=old`.",
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"metadata", "node_selector"},
+			},
+			"ignore_daemonsets": {
+				Type:        schema.TypeBool,
+				Description: "Leave the pods managed by a DaemonSet on the nodes. Otherwise the drain fails when there are such pods.",
+				Optional:    true,
+				Default:     false,
+			},
+			"delete_emptydir_data": {
+				Type:        schema.TypeBool,
+				Description: "Evict the pods using emptyDir volumes, whose data is deleted. Otherwise the drain fails when there are such pods.",
+				Optional:    true,
+				Default:     false,
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Description: "Evict the pods that are not managed by a controller, which are not recreated elsewhere. Otherwise the drain fails when there are such pods.",
+				Optional:    true,
+				Default:     false,
+			},
+			"grace_period": {
+				Type:         schema.TypeInt,
+				Description:  "The period of time in seconds given to each pod to terminate gracefully. The grace period of the pod is used when it is negative.",
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			"uncordon_on_destroy": {
+				Type:        schema.TypeBool,
+				Description: "Uncordon the nodes when the resource is destroyed.",
+				Optional:    true,
+				Default:     false,
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Description: "The names of the drained nodes.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceKubernetesNodeDrainCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn, err := m.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var nodes []string
+	if selector, ok := d.GetOk("node_selector"); ok {
+		list, err := conn.CoreV1().Nodes().List(ctx, metav1.ListOptions{LabelSelector: selector.(string)})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, n := range list.Items {
+			nodes = append(nodes, n.Name)
+		}
+		if len(nodes) == 0 {
+			return diag.Errorf("No node matches the selector %q", selector)
+		}
+		d.SetId(selector.(string))
+	} else {
+		name := expandMetadata(d.Get("metadata").([]interface{})).Name
+		_, err := conn.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return diag.Errorf("The node %q does not exist", name)
+			}
+			return diag.FromErr(err)
+		}
+		nodes = []string{name}
+		d.SetId(name)
+	}
+	sort.Strings(nodes)
+	d.Set("nodes", nodes)
+
+	for _, name := range nodes {
+		log.Printf("[INFO] Cordoning node %q", name)
+		err := setNodeUnschedulable(ctx, conn, name, true)
+		if err != nil {
+			return diag.Errorf("Failed to cordon node %q: %v", name, err)
+		}
+	}
+
+	pods, diags := podsToEvict(ctx, conn, d, nodes)
+	if diags.HasError() {
+		return diags
+	}
+	diags = append(diags, evictPods(ctx, conn, d, pods, d.Timeout(schema.TimeoutCreate))...)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, resourceKubernetesNodeDrainRead(ctx, d, m)...)
+}
+
+func resourceKubernetesNodeDrainRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn, err := m.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var nodes []string
+	cordoned := 0
+	for _, name := range expandStringSlice(d.Get("nodes").([]interface{})) {
+		node, err := conn.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return diag.FromErr(err)
+		}
+		nodes = append(nodes, name)
+		if node.Spec.Unschedulable {
+			cordoned++
+		}
+	}
+	if cordoned == 0 {
+		// the nodes are gone or have been uncordoned outside of Terraform
+		log.Printf("[INFO] None of the nodes of %q is cordoned, removing it from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	d.Set("nodes", nodes)
+	return nil
+}
+
+func resourceKubernetesNodeDrainUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// the other attributes only change how the nodes are drained or released
+	return resourceKubernetesNodeDrainRead(ctx, d, m)
+}
+
+func resourceKubernetesNodeDrainDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.Get("uncordon_on_destroy").(bool) {
+		conn, err := m.(KubeClientsets).MainClientset()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, name := range expandStringSlice(d.Get("nodes").([]interface{})) {
+			log.Printf("[INFO] Uncordoning node %q", name)
+			err := setNodeUnschedulable(ctx, conn, name, false)
+			if err != nil && !errors.IsNotFound(err) {
+				return diag.Errorf("Failed to uncordon node %q: %v", name, err)
+			}
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+func setNodeUnschedulable(ctx context.Context, conn *kubernetes.Clientset, name string, unschedulable bool) error {
+	patch := fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, unschedulable)
+	_, err := conn.CoreV1().Nodes().Patch(ctx, name, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+	return err
+}
+
+// podsToEvict returns the pods to evict from nodes, as `kubectl drain` selects
+// them. Pods that cannot be evicted with the options of d are reported as errors.
+func podsToEvict(ctx context.Context, conn *kubernetes.Clientset, d *schema.ResourceData, nodes []string) ([]api.Pod, diag.Diagnostics) {
+	var pods []api.Pod
+	var refused []string
+	for _, name := range nodes {
+		list, err := conn.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("spec.nodeName", name).String(),
+		})
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		for _, pod := range list.Items {
+			if _, ok := pod.Annotations[mirrorPodAnnotation]; ok {
+				// static pods cannot be evicted
+				continue
+			}
+			if reason := drainRefusal(d, pod); reason != "" {
+				if reason == "daemonset" {
+					continue
+				}
+				refused = append(refused, fmt.Sprintf("%s/%s (%s)", pod.Namespace, pod.Name, reason))
+				continue
+			}
+			pods = append(pods, pod)
+		}
+	}
+	if len(refused) > 0 {
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Cannot drain nodes",
+			Detail:   fmt.Sprintf("The following pods cannot be evicted with the current options: %s", strings.Join(refused, ", ")),
+		}}
+	}
+	return pods, nil
+}
+
+// drainRefusal returns why pod cannot be evicted with the options of d, or
+// "daemonset" when it is left on the node, or an empty string.
+func drainRefusal(d *schema.ResourceData, pod api.Pod) string {
+	if pod.Status.Phase == api.PodSucceeded || pod.Status.Phase == api.PodFailed {
+		return ""
+	}
+	controller := metav1.GetControllerOf(&pod)
+	if controller != nil && controller.Kind == "DaemonSet" {
+		if d.Get("ignore_daemonsets").(bool) {
+			return "daemonset"
+		}
+		return `managed by a DaemonSet, set "ignore_daemonsets" to leave it`
+	}
+	if controller == nil && !d.Get("force").(bool) {
+		return `not managed by a controller, set "force" to evict it`
+	}
+	for _, v := range pod.Spec.Volumes {
+		if v.EmptyDir != nil && !d.Get("delete_emptydir_data").(bool) {
+			return `uses an emptyDir volume, set "delete_emptydir_data" to evict it`
+		}
+	}
+	return ""
+}
+
+// evictPods evicts pods and waits for them to be deleted. Evictions refused
+// because of a PodDisruptionBudget are retried until the timeout.
+func evictPods(ctx context.Context, conn *kubernetes.Clientset, d *schema.ResourceData, pods []api.Pod, timeout time.Duration) diag.Diagnostics {
+	deleteOptions := &metav1.DeleteOptions{}
+	if gp := int64(d.Get("grace_period").(int)); gp >= 0 {
+		deleteOptions.GracePeriodSeconds = &gp
+	}
+
+	evicted := make(map[types.UID]bool, len(pods))
+	blocked := map[types.UID]api.Pod{}
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		remaining := 0
+		for _, pod := range pods {
+			if !evicted[pod.UID] {
+				log.Printf("[INFO] Evicting pod %s/%s", pod.Namespace, pod.Name)
+				err := conn.CoreV1().Pods(pod.Namespace).EvictV1(ctx, &policy.Eviction{
+					ObjectMeta:    metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+					DeleteOptions: deleteOptions,
+				})
+				switch {
+				case err == nil, errors.IsNotFound(err):
+					evicted[pod.UID] = true
+					delete(blocked, pod.UID)
+				case errors.IsTooManyRequests(err):
+					// the eviction would violate a PodDisruptionBudget
+					blocked[pod.UID] = pod
+					remaining++
+					continue
+				default:
+					return retry.NonRetryableError(fmt.Errorf("failed to evict pod %s/%s: %s", pod.Namespace, pod.Name, err))
+				}
+			}
+			// wait for the evicted pod to be gone
+			p, err := conn.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+			if err != nil && !errors.IsNotFound(err) {
+				return retry.NonRetryableError(err)
+			}
+			if err == nil && p.UID == pod.UID {
+				remaining++
+			}
+		}
+		if remaining > 0 {
+			return retry.RetryableError(fmt.Errorf("waiting for %d pods to be evicted", remaining))
+		}
+		return nil
+	})
+	if err == nil {
+		return nil
+	}
+
+	diags := diag.FromErr(err)
+	for _, pod := range blocked {
+		diags = append(diags, blockingDisruptionBudgets(ctx, conn, pod)...)
+	}
+	return diags
+}
+
+// blockingDisruptionBudgets reports the PodDisruptionBudgets selecting pod,
+// which prevented its eviction.
+func blockingDisruptionBudgets(ctx context.Context, conn *kubernetes.Clientset, pod api.Pod) diag.Diagnostics {
+	list, err := conn.PolicyV1().PodDisruptionBudgets(pod.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("[WARN] Failed to list the PodDisruptionBudgets of namespace %q: %s", pod.Namespace, err)
+		return nil
+	}
+	var diags diag.Diagnostics
+	for _, pdb := range list.Items {
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil || selector.Empty() || !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Eviction blocked by PodDisruptionBudget",
+			Detail: fmt.Sprintf("The eviction of pod %s/%s is blocked by PodDisruptionBudget %s/%s, which allows %d disruptions (%d healthy pods, %d desired).",
+				pod.Namespace, pod.Name, pdb.Namespace, pdb.Name,
+				pdb.Status.DisruptionsAllowed, pdb.Status.CurrentHealthy, pdb.Status.DesiredHealthy),
+		})
+	}
+	return diags
+}
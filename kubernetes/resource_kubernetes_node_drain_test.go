@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestAccKubernetesNodeDrain_noMatchingNode(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "kubernetes_node_drain" "test" {
+  node_selector = "tf-acc-test/no-such-label=true"
+}
+`,
+				ExpectError: regexp.MustCompile("No node matches the selector"),
+			},
+		},
+	})
+}
+
+func TestNodeDrainRefusal(t *testing.T) {
+	controlled := func(kind string) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{Kind: kind, Name: "owner", Controller: ptr.To(true)}}
+	}
+	emptyDir := []api.Volume{{Name: "cache", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}}}
+
+	cases := map[string]struct {
+		options  map[string]interface{}
+		pod      api.Pod
+		expected string
+	}{
+		"replicaset": {
+			pod: api.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: controlled("ReplicaSet")}},
+		},
+		"daemonset": {
+			pod:      api.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: controlled("DaemonSet")}},
+			expected: `managed by a DaemonSet, set "ignore_daemonsets" to leave it`,
+		},
+		"ignored daemonset": {
+			options:  map[string]interface{}{"ignore_daemonsets": true},
+			pod:      api.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: controlled("DaemonSet")}},
+			expected: "daemonset",
+		},
+		"unmanaged": {
+			pod:      api.Pod{},
+			expected: `not managed by a controller, set "force" to evict it`,
+		},
+		"forced unmanaged": {
+			options: map[string]interface{}{"force": true},
+			pod:     api.Pod{},
+		},
+		"completed unmanaged": {
+			pod: api.Pod{Status: api.PodStatus{Phase: api.PodSucceeded}},
+		},
+		"emptydir": {
+			pod: api.Pod{
+				ObjectMeta: metav1.ObjectMeta{OwnerReferences: controlled("ReplicaSet")},
+				Spec:       api.PodSpec{Volumes: emptyDir},
+			},
+			expected: `uses an emptyDir volume, set "delete_emptydir_data" to evict it`,
+		},
+		"deleted emptydir": {
+			options: map[string]interface{}{"delete_emptydir_data": true},
+			pod: api.Pod{
+				ObjectMeta: metav1.ObjectMeta{OwnerReferences: controlled("ReplicaSet")},
+				Spec:       api.PodSpec{Volumes: emptyDir},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]interface{}{"node_selector": "pool=old"}
+			for k, v := range tc.options {
+				raw[k] = v
+			}
+			d := schema.TestResourceDataRaw(t, resourceKubernetesNodeDrain().Schema, raw)
+			if got := drainRefusal(d, tc.pod); got != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,463 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+)
+
+func resourceKubernetesPatch() *schema.Resource {
+	return &schema.Resource{
+		Description:   "This resource allows Terraform to manage arbitrary fields of a resource that already exists and is managed elsewhere, such as the Corefile of coredns or the `imagePullSecrets` of a default service account. The fields are set with [server-side apply](https://kubernetes.io/docs/reference/using-api/server-side-apply/) under a field manager of their own, and only those fields are checked for drift. Destroying this resource releases the ownership of the fields, or restores the values they had before when `restore_on_destroy` is set.",
+		CreateContext: resourceKubernetesPatchCreate,
+		ReadContext:   resourceKubernetesPatchRead,
+		UpdateContext: resourceKubernetesPatchUpdate,
+		DeleteContext: resourceKubernetesPatchDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"api_version": {
+				Type:        schema.TypeString,
+				Description: "The apiVersion of the resource to patch.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"kind": {
+				Type:        schema.TypeString,
+				Description: "The kind of the resource to patch.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the resource.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Description: "The namespace of the resource. The provider namespace is used when it is not set.",
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+			"patch": {
+				Type:             schema.TypeString,
+				Description:      "The fields to set on the resource, as a partial object in JSON or YAML, e.g. from `jsonencode` or `yamlencode`. It cannot set the apiVersion, kind, name or namespace of the resource.",
+				Required:         true,
+				ValidateFunc:     validatePatchObject,
+				DiffSuppressFunc: suppressEquivalentPatch,
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Description: "Force overwriting fields that are managed outside of Terraform.",
+				Optional:    true,
+			},
+			"field_manager": {
+				Type:         schema.TypeString,
+				Description:  "Set the name of the field manager for the patched fields. A unique name is generated when it is not set. Two resources patching the same object must not use the same name.",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"restore_on_destroy": {
+				Type:        schema.TypeBool,
+				Description: "Restore the values the patched fields had before they were first patched when the resource is destroyed. Otherwise the fields are left as they are and only their ownership is released.",
+				Optional:    true,
+				Default:     false,
+			},
+			"wait_for_ready": waitForReadySchema(),
+			"previous": {
+				Type:        schema.TypeString,
+				Description: "The values the patched fields had before they were first patched, as JSON.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// parsePatchObject parses a partial object written in JSON or YAML.
+func parsePatchObject(s string) (map[string]interface{}, error) {
+	obj := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(s), &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func validatePatchObject(value interface{}, key string) ([]string, []error) {
+	obj, err := parsePatchObject(value.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q must be an object in JSON or YAML: %s", key, err)}
+	}
+	var errs []error
+	for _, k := range []string{"apiVersion", "kind"} {
+		if _, ok := obj[k]; ok {
+			errs = append(errs, fmt.Errorf("%q cannot set %q", key, k))
+		}
+	}
+	if md, ok := obj["metadata"].(map[string]interface{}); ok {
+		for _, k := range []string{"name", "namespace"} {
+			if _, ok := md[k]; ok {
+				errs = append(errs, fmt.Errorf("%q cannot set \"metadata.%s\"", key, k))
+			}
+		}
+	}
+	return nil, errs
+}
+
+func suppressEquivalentPatch(k, old, new string, d *schema.ResourceData) bool {
+	o, err := parsePatchObject(old)
+	if err != nil {
+		return false
+	}
+	n, err := parsePatchObject(new)
+	if err != nil {
+		return false
+	}
+	return patchEqual(o, n)
+}
+
+// patchEqual compares two partial objects, regardless of how their numbers are typed.
+func patchEqual(a, b interface{}) bool {
+	na, err := normalizePatchValue(a)
+	if err != nil {
+		return false
+	}
+	nb, err := normalizePatchValue(b)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(na, nb)
+}
+
+func normalizePatchValue(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = json.Unmarshal(data, &out)
+	return out, err
+}
+
+// previousPatchValues returns the values in current of the fields set in patch.
+// Objects are walked down to their fields, any other value is taken as a whole.
+func previousPatchValues(current, patch map[string]interface{}) map[string]interface{} {
+	out := map[string]interface{}{}
+	for k, pv := range patch {
+		cv, ok := current[k]
+		if !ok {
+			continue
+		}
+		pm, pok := pv.(map[string]interface{})
+		cm, cok := cv.(map[string]interface{})
+		if pok && cok {
+			out[k] = previousPatchValues(cm, pm)
+			continue
+		}
+		out[k] = cv
+	}
+	return out
+}
+
+// addedPatchFields returns the fields of patch that are not set in old.
+func addedPatchFields(patch, old map[string]interface{}) map[string]interface{} {
+	out := map[string]interface{}{}
+	for k, pv := range patch {
+		ov, ok := old[k]
+		if !ok {
+			out[k] = pv
+			continue
+		}
+		pm, pok := pv.(map[string]interface{})
+		om, ook := ov.(map[string]interface{})
+		if pok && ook {
+			if added := addedPatchFields(pm, om); len(added) > 0 {
+				out[k] = added
+			}
+		}
+	}
+	return out
+}
+
+// mergePatchValues merges src into dst, keeping the values already in dst.
+func mergePatchValues(dst, src map[string]interface{}) {
+	for k, sv := range src {
+		dv, ok := dst[k]
+		if !ok {
+			dst[k] = sv
+			continue
+		}
+		sm, sok := sv.(map[string]interface{})
+		dm, dok := dv.(map[string]interface{})
+		if sok && dok {
+			mergePatchValues(dm, sm)
+		}
+	}
+}
+
+// generateFieldManager returns a unique field manager name for a resource of
+// the given type, so that resources applying to the same object do not take
+// the fields of one another.
+func generateFieldManager(resourceType string) (string, error) {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%s-%s", defaultFieldManagerName, resourceType, hex.EncodeToString(b)), nil
+}
+
+func resourceKubernetesPatchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if _, ok := d.GetOk("field_manager"); !ok {
+		fieldManager, err := generateFieldManager("patch")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("field_manager", fieldManager)
+	}
+	metadata, err := expandTargetMetadata(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(buildIdWithVersionKind(metadata,
+		d.Get("api_version").(string),
+		d.Get("kind").(string)))
+	diags := resourceKubernetesPatchUpdate(ctx, d, m)
+	if diags.HasError() {
+		d.SetId("")
+	}
+	return diags
+}
+
+// patchResourceInterface returns the client for the resource targeted by the ID of d.
+func patchResourceInterface(d *schema.ResourceData, m interface{}) (dynamic.ResourceInterface, string, error) {
+	gvk, name, namespace, err := util.ParseResourceID(d.Id(), util.DefaultNamespace)
+	if err != nil {
+		return nil, "", err
+	}
+	r, err := dynamicResourceInterface(m, gvk.GroupVersion().String(), gvk.Kind, namespace)
+	if err != nil {
+		return nil, "", err
+	}
+	return r, name, nil
+}
+
+func resourceKubernetesPatchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	r, name, err := patchResourceInterface(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := r.Get(ctx, name, v1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  "Resource deleted",
+				Detail:   fmt.Sprintf("The underlying resource %q has been deleted. You should recreate the underlying resource, or remove it from your configuration.", name),
+			}}
+		}
+		return diag.FromErr(err)
+	}
+
+	// only the fields still owned by the field manager of this resource are
+	// compared with the patch, so that a change made by another client shows as drift
+	owned := util.ManagedFieldsOnly(res, d.Get("field_manager").(string))
+	delete(owned, "apiVersion")
+	delete(owned, "kind")
+	if md, ok := owned["metadata"].(map[string]interface{}); ok {
+		delete(md, "name")
+		delete(md, "namespace")
+		if len(md) == 0 {
+			delete(owned, "metadata")
+		}
+	}
+
+	configured, err := parsePatchObject(d.Get("patch").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !patchEqual(owned, configured) {
+		data, err := json.Marshal(owned)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("patch", string(data))
+	}
+	return nil
+}
+
+// applyPatchObject server-side applies the fields in obj to the resource res.
+func applyPatchObject(ctx context.Context, d *schema.ResourceData, r dynamic.ResourceInterface, res *unstructured.Unstructured, obj map[string]interface{}) error {
+	body := map[string]interface{}{}
+	for k, v := range obj {
+		body[k] = v
+	}
+	md, _ := body["metadata"].(map[string]interface{})
+	metadata := map[string]interface{}{}
+	for k, v := range md {
+		metadata[k] = v
+	}
+	metadata["name"] = res.GetName()
+	if ns := res.GetNamespace(); ns != "" {
+		metadata["namespace"] = ns
+	}
+	body["apiVersion"] = d.Get("api_version")
+	body["kind"] = d.Get("kind")
+	body["metadata"] = metadata
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	_, err = r.Patch(ctx, res.GetName(), types.ApplyPatchType, data, v1.PatchOptions{
+		FieldManager: d.Get("field_manager").(string),
+		Force:        ptr.To(d.Get("force").(bool)),
+	})
+	return err
+}
+
+func resourceKubernetesPatchUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	r, name, err := patchResourceInterface(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// check the resource exists before we try and patch it
+	res, err := r.Get(ctx, name, v1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return diag.Errorf("The %s %q does not exist", d.Get("kind"), name)
+		}
+		return diag.Errorf("Have got the following error while validating the existence of the %s %q: %v", d.Get("kind"), name, err)
+	}
+
+	patch, err := parsePatchObject(d.Get("patch").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// record the values of the fields patched for the first time
+	previous := map[string]interface{}{}
+	added := patch
+	if !d.IsNewResource() {
+		if p := d.Get("previous").(string); p != "" {
+			if err := json.Unmarshal([]byte(p), &previous); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		o, _ := d.GetChange("patch")
+		old, err := parsePatchObject(o.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		added = addedPatchFields(patch, old)
+	}
+	mergePatchValues(previous, previousPatchValues(res.Object, added))
+	data, err := json.Marshal(previous)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("previous", string(data))
+
+	log.Printf("[INFO] Patching %s %q", d.Get("kind"), name)
+	err = applyPatchObject(ctx, d, r, res, patch)
+	if err != nil {
+		if errors.IsConflict(err) {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Field manager conflict",
+				Detail:   fmt.Sprintf(`Another client is managing a field Terraform tried to update. Set "force" to true to override: %v`, err),
+			}}
+		}
+		return diag.FromErr(err)
+	}
+
+	if d.Get("wait_for_ready").(bool) {
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if d.IsNewResource() {
+			timeout = d.Timeout(schema.TimeoutCreate)
+		}
+		log.Printf("[INFO] Waiting for %s %q to be ready", d.Get("kind"), name)
+		if err := waitForReady(ctx, r, name, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesPatchRead(ctx, d, m)
+}
+
+func resourceKubernetesPatchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	r, name, err := patchResourceInterface(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := r.Get(ctx, name, v1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// if we are deleting then there is nothing to do
+			// if the resource is gone
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if d.Get("restore_on_destroy").(bool) {
+		previous := map[string]interface{}{}
+		if p := d.Get("previous").(string); p != "" {
+			if err := json.Unmarshal([]byte(p), &previous); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		// applying the previous values removes the fields that did not exist before
+		log.Printf("[INFO] Restoring the fields patched on %s %q", d.Get("kind"), name)
+		err = applyPatchObject(ctx, d, r, res, previous)
+		if err != nil {
+			return diag.Errorf("Failed to restore the fields patched on %s %q: %v", d.Get("kind"), name, err)
+		}
+	}
+
+	// the fields keep their values, they are only no longer owned by this resource
+	err = util.ReleaseFieldManager(ctx, r, name, d.Get("field_manager").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesPatch_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	namespace := "default"
+	resourceName := "kubernetes_patch.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			createConfigMap(name, namespace)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			return destroyConfigMap(name, namespace)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPatch_basic(name, "one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "field_manager", "tftest"),
+					resource.TestCheckResourceAttr(resourceName, "previous", "{}"),
+					testAccCheckKubernetesPatchConfigMapData(name, namespace, map[string]string{"test1": "one"}),
+				),
+			},
+			{
+				Config: testAccKubernetesPatch_basic(name, "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPatchConfigMapData(name, namespace, map[string]string{"test1": "two"}),
+				),
+			},
+			{
+				Config: testAccKubernetesPatch_empty(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// the restored ConfigMap has no data left
+					testAccCheckKubernetesPatchConfigMapData(name, namespace, map[string]string{}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesPatchConfigMapData(name, namespace string, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		cm, err := conn.CoreV1().ConfigMaps(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		data := cm.Data
+		if data == nil {
+			data = map[string]string{}
+		}
+		if !reflect.DeepEqual(data, expected) {
+			return fmt.Errorf("expected the data of ConfigMap %q to be %v, got %v", name, expected, data)
+		}
+		return nil
+	}
+}
+
+func TestKubernetesPatchPreviousValues(t *testing.T) {
+	current := map[string]interface{}{
+		"data": map[string]interface{}{
+			"Corefile": "old",
+			"other":    "kept",
+		},
+		"imagePullSecrets": []interface{}{
+			map[string]interface{}{"name": "a"},
+		},
+	}
+	patch := map[string]interface{}{
+		"data": map[string]interface{}{
+			"Corefile": "new",
+			"added":    "new",
+		},
+		"imagePullSecrets": []interface{}{
+			map[string]interface{}{"name": "b"},
+		},
+		"automountServiceAccountToken": false,
+	}
+	expected := map[string]interface{}{
+		"data": map[string]interface{}{
+			"Corefile": "old",
+		},
+		"imagePullSecrets": []interface{}{
+			map[string]interface{}{"name": "a"},
+		},
+	}
+	if previous := previousPatchValues(current, patch); !reflect.DeepEqual(previous, expected) {
+		t.Fatalf("expected %v, got %v", expected, previous)
+	}
+}
+
+func TestKubernetesPatchAddedFields(t *testing.T) {
+	old := map[string]interface{}{
+		"data": map[string]interface{}{
+			"a": "1",
+		},
+	}
+	patch := map[string]interface{}{
+		"data": map[string]interface{}{
+			"a": "2",
+			"b": "1",
+		},
+		"spec": map[string]interface{}{
+			"replicas": 1,
+		},
+	}
+	expected := map[string]interface{}{
+		"data": map[string]interface{}{
+			"b": "1",
+		},
+		"spec": map[string]interface{}{
+			"replicas": 1,
+		},
+	}
+	if added := addedPatchFields(patch, old); !reflect.DeepEqual(added, expected) {
+		t.Fatalf("expected %v, got %v", expected, added)
+	}
+}
+
+func TestKubernetesPatchEqual(t *testing.T) {
+	a, err := parsePatchObject("spec:\n  replicas: 2\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := parsePatchObject(`{"spec":{"replicas":2}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !patchEqual(a, b) {
+		t.Fatal("expected the YAML and JSON patches to be equal")
+	}
+	if !patchEqual(map[string]interface{}{"spec": map[string]interface{}{"replicas": int64(2)}}, b) {
+		t.Fatal("expected the patches to be equal regardless of the type of their numbers")
+	}
+	if patchEqual(a, map[string]interface{}{}) {
+		t.Fatal("expected the patches to differ")
+	}
+}
+
+func testAccKubernetesPatch_basic(name, value string) string {
+	return fmt.Sprintf(`resource "kubernetes_patch" "test" {
+  api_version = "v1"
+  kind        = "ConfigMap"
+  metadata {
+    name = %q
+  }
+  patch = jsonencode({
+    data = {
+      test1 = %q
+    }
+  })
+  field_manager      = "tftest"
+  restore_on_destroy = true
+}
+`, name, value)
+}
+
+func testAccKubernetesPatch_empty(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_config_map_v1_data" "test" {
+  metadata {
+    name = %q
+  }
+  data          = {}
+  field_manager = "tftest-data"
+}
+`, name)
+}
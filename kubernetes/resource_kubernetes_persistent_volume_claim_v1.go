@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	k8sresource "k8s.io/apimachinery/pkg/api/resource"
@@ -30,6 +31,9 @@ func resourceKubernetesPersistentVolumeClaimV1() *schema.Resource {
 		Optional:    true,
 		Default:     true,
 	}
+	fields["delete_options"] = deleteOptionsSchema()
+	fields["destroy_behavior"] = destroyBehaviorSchema()
+	fields["wait_for_ready"] = waitForReadySchema()
 	return &schema.Resource{
 		Description:   "This resource allows the user to request for and claim to a persistent volume.",
 		CreateContext: resourceKubernetesPersistentVolumeClaimV1Create,
@@ -103,6 +107,7 @@ func resourceKubernetesPersistentVolumeClaimV1Create(ctx context.Context, d *sch
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	claim.ObjectMeta = expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
 	log.Printf("[INFO] Creating new persistent volume claim: %#v", claim)
 	out, err := conn.CoreV1().PersistentVolumeClaims(claim.Namespace).Create(ctx, claim, metav1.CreateOptions{})
 	if err != nil {
@@ -149,11 +154,15 @@ func resourceKubernetesPersistentVolumeClaimV1Create(ctx context.Context, d *sch
 				}
 			}
 
-			return diag.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+			return diag.Errorf("%s%s", err, util.StringifyEvents(lastWarnings))
 		}
 	}
 	log.Printf("[INFO] Persistent volume claim %s created", out.Name)
 
+	if diags := waitForObjectReady(ctx, d, meta, "v1", "PersistentVolumeClaim", out, schema.TimeoutCreate); diags.HasError() {
+		return diags
+	}
+
 	return resourceKubernetesPersistentVolumeClaimV1Read(ctx, d, meta)
 }
 
@@ -206,7 +215,7 @@ func resourceKubernetesPersistentVolumeClaimV1Update(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	// spec.resources.requests is the only editable field in Spec.
 	if d.HasChange("spec.0.resources.0.requests") {
 		r := d.Get("spec.0.resources.0.requests").(map[string]interface{})
@@ -231,10 +240,18 @@ func resourceKubernetesPersistentVolumeClaimV1Update(ctx context.Context, d *sch
 	}
 	log.Printf("[INFO] Submitted updated persistent volume claim: %#v", out)
 
+	if diags := waitForObjectReady(ctx, d, meta, "v1", "PersistentVolumeClaim", out, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
 	return resourceKubernetesPersistentVolumeClaimV1Read(ctx, d, meta)
 }
 
 func resourceKubernetesPersistentVolumeClaimV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "v1", "PersistentVolumeClaim")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -246,7 +263,7 @@ func resourceKubernetesPersistentVolumeClaimV1Delete(ctx context.Context, d *sch
 	}
 
 	log.Printf("[INFO] Deleting persistent volume claim: %#v", name)
-	err = conn.CoreV1().PersistentVolumeClaims(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().PersistentVolumeClaims(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
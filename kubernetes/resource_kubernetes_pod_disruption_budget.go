@@ -36,7 +36,9 @@ func resourceKubernetesPodDisruptionBudget() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": namespacedMetadataSchema("pod disruption budget", true),
+			"metadata":         namespacedMetadataSchema("pod disruption budget", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			// Updates to spec not allowed until Kubernetes dependencies are updated to
 			// 1.13; have to delete and recreate until then
 			// https://github.com/kubernetes/kubernetes/issues/45398
@@ -90,7 +92,7 @@ func resourceKubernetesPodDisruptionBudgetUpdate(ctx context.Context, d *schema.
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	data, err := ops.MarshalJSON()
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
@@ -114,7 +116,7 @@ func resourceKubernetesPodDisruptionBudgetCreate(ctx context.Context, d *schema.
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
 	spec, err := expandPodDisruptionBudgetSpec(d.Get("spec").([]interface{}))
 	if err != nil {
 		return diag.FromErr(err)
@@ -177,6 +179,10 @@ func resourceKubernetesPodDisruptionBudgetRead(ctx context.Context, d *schema.Re
 }
 
 func resourceKubernetesPodDisruptionBudgetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "policy/v1beta1", "PodDisruptionBudget")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -188,7 +194,7 @@ func resourceKubernetesPodDisruptionBudgetDelete(ctx context.Context, d *schema.
 	}
 
 	log.Printf("[INFO] Deleting pod disruption budget %#v", name)
-	err = conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
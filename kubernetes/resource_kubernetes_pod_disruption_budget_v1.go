@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,7 +26,7 @@ var (
 )
 
 func resourceKubernetesPodDisruptionBudgetV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "A Pod Disruption Budget limits the number of pods of a replicated application that are down simultaneously from voluntary disruptions. For example, a quorum-based application would like to ensure that the number of replicas running is never brought below the number needed for a quorum. A web front end might want to ensure that the number of replicas serving load never falls below a certain percentage of the total.",
 		CreateContext: resourceKubernetesPodDisruptionBudgetV1Create,
 		ReadContext:   resourceKubernetesPodDisruptionBudgetV1Read,
@@ -36,7 +37,9 @@ func resourceKubernetesPodDisruptionBudgetV1() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": namespacedMetadataSchema("pod disruption budget", true),
+			"metadata":         namespacedMetadataSchema("pod disruption budget", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			// Updates to spec not allowed until Kubernetes dependencies are updated to
 			// 1.13; have to delete and recreate until then
 			// https://github.com/kubernetes/kubernetes/issues/45398
@@ -76,10 +79,14 @@ func resourceKubernetesPodDisruptionBudgetV1() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, resourceKubernetesPodDisruptionBudgetV1DryRun)
 }
 
 func resourceKubernetesPodDisruptionBudgetV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesPodDisruptionBudgetV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -90,7 +97,7 @@ func resourceKubernetesPodDisruptionBudgetV1Update(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	data, err := ops.MarshalJSON()
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
@@ -108,24 +115,57 @@ func resourceKubernetesPodDisruptionBudgetV1Update(ctx context.Context, d *schem
 	return resourceKubernetesPodDisruptionBudgetV1Read(ctx, d, meta)
 }
 
+// resourceKubernetesPodDisruptionBudgetV1Apply updates a pod disruption budget
+// with server-side apply.
+func resourceKubernetesPodDisruptionBudgetV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	pdb, err := expandPodDisruptionBudgetV1(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	out, err := applyUpdate(ctx, d, meta, "policy/v1", "PodDisruptionBudget", pdb, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Submitted updated pod disruption budget: %#v", out)
+
+	return resourceKubernetesPodDisruptionBudgetV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesPodDisruptionBudgetV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	pdb, err := expandPodDisruptionBudgetV1(d, meta)
+	if err != nil {
+		return err
+	}
+	client := conn.PolicyV1().PodDisruptionBudgets(pdb.Namespace)
+	switch {
+	case !update:
+		_, err = client.Create(ctx, pdb, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "policy/v1", "PodDisruptionBudget", pdb, nil)
+	default:
+		err = dryRunPatch(ctx, pdb, client.Patch)
+	}
+	return err
+}
+
 func resourceKubernetesPodDisruptionBudgetV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandPodDisruptionBudgetV1Spec(d.Get("spec").([]interface{}))
+	pdb, err := expandPodDisruptionBudgetV1(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	pdb := policy.PodDisruptionBudget{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
 
 	log.Printf("[INFO] Creating new pod disruption budget: %#v", pdb)
-	out, err := conn.PolicyV1().PodDisruptionBudgets(metadata.Namespace).Create(ctx, &pdb, metav1.CreateOptions{})
+	out, err := createOrApply(ctx, meta, "policy/v1", "PodDisruptionBudget", pdb, conn.PolicyV1().PodDisruptionBudgets(pdb.Namespace).Create)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -136,6 +176,18 @@ func resourceKubernetesPodDisruptionBudgetV1Create(ctx context.Context, d *schem
 	return resourceKubernetesPodDisruptionBudgetV1Read(ctx, d, meta)
 }
 
+// expandPodDisruptionBudgetV1 builds the pod disruption budget of d.
+func expandPodDisruptionBudgetV1(d *schema.ResourceData, meta interface{}) (*policy.PodDisruptionBudget, error) {
+	spec, err := expandPodDisruptionBudgetV1Spec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &policy.PodDisruptionBudget{
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesPodDisruptionBudgetV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesPodDisruptionBudgetV1Exists(ctx, d, meta)
 	if err != nil {
@@ -177,6 +229,10 @@ func resourceKubernetesPodDisruptionBudgetV1Read(ctx context.Context, d *schema.
 }
 
 func resourceKubernetesPodDisruptionBudgetV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "policy/v1", "PodDisruptionBudget")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -188,7 +244,7 @@ func resourceKubernetesPodDisruptionBudgetV1Delete(ctx context.Context, d *schem
 	}
 
 	log.Printf("[INFO] Deleting pod disruption budget %#v", name)
-	err = conn.PolicyV1().PodDisruptionBudgets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.PolicyV1().PodDisruptionBudgets(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
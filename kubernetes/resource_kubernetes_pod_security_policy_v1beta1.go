@@ -77,7 +77,9 @@ func resourceKubernetesPodSecurityPolicyV1Beta1() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": metadataSchema("podsecuritypolicy", false),
+			"metadata":         metadataSchema("podsecuritypolicy", false),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"spec": {
 				Type:        schema.TypeList,
 				Description: pspSpecDoc,
@@ -375,7 +377,7 @@ func resourceKubernetesPodSecurityPolicyV1Beta1Create(ctx context.Context, d *sc
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
 	spec, err := expandPodSecurityPolicySpec(d.Get("spec").([]interface{}))
 
 	if err != nil {
@@ -446,7 +448,7 @@ func resourceKubernetesPodSecurityPolicyV1Beta1Update(ctx context.Context, d *sc
 
 	name := d.Id()
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 
 	if d.HasChange("spec") {
 		diffOps := patchPodSecurityPolicySpec("spec.0.", "/spec", d)
@@ -468,6 +470,10 @@ func resourceKubernetesPodSecurityPolicyV1Beta1Update(ctx context.Context, d *sc
 }
 
 func resourceKubernetesPodSecurityPolicyV1Beta1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "policy/v1beta1", "PodSecurityPolicy")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -476,7 +482,7 @@ func resourceKubernetesPodSecurityPolicyV1Beta1Delete(ctx context.Context, d *sc
 	name := d.Id()
 
 	log.Printf("[INFO] Deleting PodSecurityPolicy: %#v", name)
-	err = conn.PolicyV1beta1().PodSecurityPolicies().Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.PolicyV1beta1().PodSecurityPolicies().Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
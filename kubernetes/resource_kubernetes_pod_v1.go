@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -48,7 +49,10 @@ func resourceKubernetesPodV1() *schema.Resource {
 
 func resourceKubernetesPodSchemaV1() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
-		"metadata": namespacedMetadataSchema("pod", true),
+		"metadata":         namespacedMetadataSchema("pod", true),
+		"delete_options":   deleteOptionsSchema(),
+		"destroy_behavior": destroyBehaviorSchema(),
+		"wait_for_ready":   waitForReadySchema(),
 		"spec": {
 			Type:        schema.TypeList,
 			Description: "Specification of the desired behavior of the pod.",
@@ -83,7 +87,7 @@ func resourceKubernetesPodV1Create(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
 	spec, err := expandPodSpec(d.Get("spec").([]interface{}))
 	if err != nil {
 		return diag.FromErr(err)
@@ -126,10 +130,14 @@ func resourceKubernetesPodV1Create(ctx context.Context, d *schema.ResourceData,
 		if wErr != nil {
 			return diag.FromErr(wErr)
 		}
-		return diag.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+		return diag.Errorf("%s%s", err, util.StringifyEvents(lastWarnings))
 	}
 	log.Printf("[INFO] Pod %s created", out.Name)
 
+	if diags := waitForObjectReady(ctx, d, meta, "v1", "Pod", out, schema.TimeoutCreate); diags.HasError() {
+		return diags
+	}
+
 	return resourceKubernetesPodV1Read(ctx, d, meta)
 }
 
@@ -144,7 +152,7 @@ func resourceKubernetesPodV1Update(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("spec") {
 		specOps, err := patchPodSpec("/spec", "spec.0.", d)
 		if err != nil {
@@ -166,6 +174,11 @@ func resourceKubernetesPodV1Update(ctx context.Context, d *schema.ResourceData,
 	log.Printf("[INFO] Submitted updated pod: %#v", out)
 
 	d.SetId(buildId(out.ObjectMeta))
+
+	if diags := waitForObjectReady(ctx, d, meta, "v1", "Pod", out, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
 	return resourceKubernetesPodV1Read(ctx, d, meta)
 }
 
@@ -215,6 +228,10 @@ func resourceKubernetesPodV1Read(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceKubernetesPodV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "v1", "Pod")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -226,7 +243,7 @@ func resourceKubernetesPodV1Delete(ctx context.Context, d *schema.ResourceData,
 	}
 
 	log.Printf("[INFO] Deleting pod: %#v", name)
-	err = conn.CoreV1().Pods(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().Pods(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,7 +20,7 @@ import (
 )
 
 func resourceKubernetesPriorityClassV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "A PriorityClass is a non-namespaced object that defines a mapping from a priority class name to the integer value of the priority.",
 		CreateContext: resourceKubernetesPriorityClassV1Create,
 		ReadContext:   resourceKubernetesPriorityClassV1Read,
@@ -30,7 +31,9 @@ func resourceKubernetesPriorityClassV1() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": metadataSchema("priority class", true),
+			"metadata":         metadataSchema("priority class", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"description": {
 				Type:        schema.TypeString,
 				Description: "An arbitrary string that usually provides guidelines on when this priority class should be used.",
@@ -61,7 +64,7 @@ func resourceKubernetesPriorityClassV1() *schema.Resource {
 				}, false),
 			},
 		},
-	}
+	}, resourceKubernetesPriorityClassV1DryRun)
 }
 
 func resourceKubernetesPriorityClassV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -70,22 +73,9 @@ func resourceKubernetesPriorityClassV1Create(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	value := d.Get("value").(int)
-	description := d.Get("description").(string)
-	globalDefault := d.Get("global_default").(bool)
-	preemptionPolicy := d.Get("preemption_policy").(string)
-
-	priorityClass := api.PriorityClass{
-		ObjectMeta:       metadata,
-		Description:      description,
-		GlobalDefault:    globalDefault,
-		Value:            int32(value),
-		PreemptionPolicy: (*v1.PreemptionPolicy)(&preemptionPolicy),
-	}
-
+	priorityClass := expandPriorityClassV1(d, meta)
 	log.Printf("[INFO] Creating new priority class: %#v", priorityClass)
-	out, err := conn.SchedulingV1().PriorityClasses().Create(ctx, &priorityClass, metav1.CreateOptions{})
+	out, err := createOrApply(ctx, meta, "scheduling.k8s.io/v1", "PriorityClass", priorityClass, conn.SchedulingV1().PriorityClasses().Create)
 	if err != nil {
 		return diag.Errorf("Failed to create priority class: %s", err)
 	}
@@ -95,6 +85,18 @@ func resourceKubernetesPriorityClassV1Create(ctx context.Context, d *schema.Reso
 	return resourceKubernetesPriorityClassV1Read(ctx, d, meta)
 }
 
+// expandPriorityClassV1 builds the priority class of d.
+func expandPriorityClassV1(d *schema.ResourceData, meta interface{}) *api.PriorityClass {
+	preemptionPolicy := d.Get("preemption_policy").(string)
+	return &api.PriorityClass{
+		ObjectMeta:       expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+		Description:      d.Get("description").(string),
+		GlobalDefault:    d.Get("global_default").(bool),
+		Value:            int32(d.Get("value").(int)),
+		PreemptionPolicy: (*v1.PreemptionPolicy)(&preemptionPolicy),
+	}
+}
+
 func resourceKubernetesPriorityClassV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesPriorityClassV1Exists(ctx, d, meta)
 	if err != nil {
@@ -148,6 +150,10 @@ func resourceKubernetesPriorityClassV1Read(ctx context.Context, d *schema.Resour
 }
 
 func resourceKubernetesPriorityClassV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesPriorityClassV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -155,7 +161,7 @@ func resourceKubernetesPriorityClassV1Update(ctx context.Context, d *schema.Reso
 
 	name := d.Id()
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 
 	if d.HasChange("description") {
 		description := d.Get("description").(string)
@@ -196,7 +202,41 @@ func resourceKubernetesPriorityClassV1Update(ctx context.Context, d *schema.Reso
 	return resourceKubernetesPriorityClassV1Read(ctx, d, meta)
 }
 
+// resourceKubernetesPriorityClassV1Apply updates a priority class with server-side apply.
+func resourceKubernetesPriorityClassV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	out, err := applyUpdate(ctx, d, meta, "scheduling.k8s.io/v1", "PriorityClass", expandPriorityClassV1(d, meta), nil)
+	if err != nil {
+		return diag.Errorf("Failed to update priority class: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated priority class: %#v", out)
+
+	return resourceKubernetesPriorityClassV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesPriorityClassV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	priorityClass := expandPriorityClassV1(d, meta)
+	client := conn.SchedulingV1().PriorityClasses()
+	switch {
+	case !update:
+		_, err = client.Create(ctx, priorityClass, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "scheduling.k8s.io/v1", "PriorityClass", priorityClass, nil)
+	default:
+		err = dryRunPatch(ctx, priorityClass, client.Patch)
+	}
+	return err
+}
+
 func resourceKubernetesPriorityClassV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "scheduling.k8s.io/v1", "PriorityClass")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -205,7 +245,7 @@ func resourceKubernetesPriorityClassV1Delete(ctx context.Context, d *schema.Reso
 	name := d.Id()
 
 	log.Printf("[INFO] Deleting priority class: %#v", name)
-	err = conn.SchedulingV1().PriorityClasses().Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.SchedulingV1().PriorityClasses().Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
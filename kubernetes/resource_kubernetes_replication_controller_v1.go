@@ -52,7 +52,10 @@ func resourceKubernetesReplicationControllerV1() *schema.Resource {
 func resourceKubernetesReplicationControllerV1Schema() map[string]*schema.Schema {
 
 	return map[string]*schema.Schema{
-		"metadata": namespacedMetadataSchema("replication controller", true),
+		"metadata":         namespacedMetadataSchema("replication controller", true),
+		"delete_options":   deleteOptionsSchema(),
+		"destroy_behavior": destroyBehaviorSchema(),
+		"wait_for_ready":   waitForReadySchema(),
 		"spec": {
 			Type:        schema.TypeList,
 			Description: "Spec defines the specification of the desired behavior of the replication controller. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status",
@@ -117,7 +120,7 @@ func resourceKubernetesReplicationControllerV1Create(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
 
 	spec, err := expandReplicationControllerSpec(d.Get("spec").([]interface{}))
 	if err != nil {
@@ -151,6 +154,10 @@ func resourceKubernetesReplicationControllerV1Create(ctx context.Context, d *sch
 
 	log.Printf("[INFO] Submitted new replication controller: %#v", out)
 
+	if diags := waitForObjectReady(ctx, d, meta, "v1", "ReplicationController", out, schema.TimeoutCreate); diags.HasError() {
+		return diags
+	}
+
 	return resourceKubernetesReplicationControllerV1Read(ctx, d, meta)
 }
 
@@ -210,7 +217,7 @@ func resourceKubernetesReplicationControllerV1Update(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 
 	if d.HasChange("spec") {
 		spec, err := expandReplicationControllerSpec(d.Get("spec").([]interface{}))
@@ -240,10 +247,18 @@ func resourceKubernetesReplicationControllerV1Update(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 
+	if diags := waitForObjectReady(ctx, d, meta, "v1", "ReplicationController", out, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
 	return resourceKubernetesReplicationControllerV1Read(ctx, d, meta)
 }
 
 func resourceKubernetesReplicationControllerV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "v1", "ReplicationController")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -278,6 +293,11 @@ func resourceKubernetesReplicationControllerV1Delete(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 
+	deleteOptions := expandDeleteOptions(d, meta)
+	if deleteOptions.PropagationPolicy == nil {
+		// dependent pods are removed before the owner unless configured otherwise
+		deleteOptions.PropagationPolicy = &cascadeDeletePolicy
+	}
 	err = conn.CoreV1().ReplicationControllers(namespace).Delete(ctx, name, deleteOptions)
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -21,7 +22,7 @@ import (
 )
 
 func resourceKubernetesResourceQuotaV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "A resource quota provides constraints that limit aggregate resource consumption per namespace. It can limit the quantity of objects that can be created in a namespace by type, as well as the total amount of compute resources that may be consumed by resources in that project.",
 		CreateContext: resourceKubernetesResourceQuotaV1Create,
 		ReadContext:   resourceKubernetesResourceQuotaV1Read,
@@ -37,7 +38,9 @@ func resourceKubernetesResourceQuotaV1() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": namespacedMetadataSchema("resource quota", true),
+			"metadata":         namespacedMetadataSchema("resource quota", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"spec": {
 				Type:        schema.TypeList,
 				Description: "Spec defines the desired quota. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status",
@@ -105,7 +108,7 @@ func resourceKubernetesResourceQuotaV1() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, resourceKubernetesResourceQuotaV1DryRun)
 }
 
 func resourceKubernetesResourceQuotaV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -114,17 +117,13 @@ func resourceKubernetesResourceQuotaV1Create(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandResourceQuotaSpec(d.Get("spec").([]interface{}))
+	resQuota, err := expandResourceQuotaV1(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	resQuota := api.ResourceQuota{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
+	spec := resQuota.Spec
 	log.Printf("[INFO] Creating new resource quota: %#v", resQuota)
-	out, err := conn.CoreV1().ResourceQuotas(metadata.Namespace).Create(ctx, &resQuota, metav1.CreateOptions{})
+	out, err := createOrApply(ctx, meta, "v1", "ResourceQuota", resQuota, conn.CoreV1().ResourceQuotas(resQuota.Namespace).Create)
 	if err != nil {
 		return diag.Errorf("Failed to create resource quota: %s", err)
 	}
@@ -150,6 +149,18 @@ func resourceKubernetesResourceQuotaV1Create(ctx context.Context, d *schema.Reso
 	return resourceKubernetesResourceQuotaV1Read(ctx, d, meta)
 }
 
+// expandResourceQuotaV1 builds the resource quota of d.
+func expandResourceQuotaV1(d *schema.ResourceData, meta interface{}) (*api.ResourceQuota, error) {
+	spec, err := expandResourceQuotaSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &api.ResourceQuota{
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesResourceQuotaV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesResourceQuotaV1Exists(ctx, d, meta)
 	if err != nil {
@@ -198,6 +209,10 @@ func resourceKubernetesResourceQuotaV1Read(ctx context.Context, d *schema.Resour
 }
 
 func resourceKubernetesResourceQuotaV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesResourceQuotaV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -208,7 +223,7 @@ func resourceKubernetesResourceQuotaV1Update(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	var spec *api.ResourceQuotaSpec
 	waitForChangedSpec := false
 	if d.HasChange("spec") {
@@ -255,7 +270,71 @@ func resourceKubernetesResourceQuotaV1Update(ctx context.Context, d *schema.Reso
 	return resourceKubernetesResourceQuotaV1Read(ctx, d, meta)
 }
 
+// resourceKubernetesResourceQuotaV1Apply updates a resource quota with server-side apply.
+func resourceKubernetesResourceQuotaV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resQuota, err := expandResourceQuotaV1(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	out, err := applyUpdate(ctx, d, meta, "v1", "ResourceQuota", resQuota, nil)
+	if err != nil {
+		return diag.Errorf("Failed to update resource quota: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated resource quota: %#v", out)
+
+	if d.HasChange("spec") {
+		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
+			quota, err := conn.CoreV1().ResourceQuotas(out.Namespace).Get(ctx, out.Name, metav1.GetOptions{})
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+			if resourceListEquals(resQuota.Spec.Hard, quota.Status.Hard) {
+				return nil
+			}
+			err = fmt.Errorf("Quotas don't match after update.\nExpected: %#v\nGiven: %#v",
+				resQuota.Spec.Hard, quota.Status.Hard)
+			return retry.RetryableError(err)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesResourceQuotaV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesResourceQuotaV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	resQuota, err := expandResourceQuotaV1(d, meta)
+	if err != nil {
+		return err
+	}
+	client := conn.CoreV1().ResourceQuotas(resQuota.Namespace)
+	switch {
+	case !update:
+		_, err = client.Create(ctx, resQuota, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "v1", "ResourceQuota", resQuota, nil)
+	default:
+		err = dryRunPatch(ctx, resQuota, client.Patch)
+	}
+	return err
+}
+
 func resourceKubernetesResourceQuotaV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "v1", "ResourceQuota")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -267,7 +346,7 @@ func resourceKubernetesResourceQuotaV1Delete(ctx context.Context, d *schema.Reso
 	}
 
 	log.Printf("[INFO] Deleting resource quota: %#v", name)
-	err = conn.CoreV1().ResourceQuotas(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().ResourceQuotas(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -16,7 +17,7 @@ import (
 )
 
 func resourceKubernetesRoleBindingV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "A RoleBinding may be used to grant permission at the namespace level",
 		CreateContext: resourceKubernetesRoleBindingV1Create,
 		ReadContext:   resourceKubernetesRoleBindingV1Read,
@@ -27,7 +28,9 @@ func resourceKubernetesRoleBindingV1() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": metadataSchemaRBAC("roleBinding", true, true),
+			"metadata":         metadataSchemaRBAC("roleBinding", true, true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"role_ref": {
 				Type:        schema.TypeList,
 				Description: "RoleRef references the Role for this binding",
@@ -48,7 +51,7 @@ func resourceKubernetesRoleBindingV1() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, resourceKubernetesRoleBindingV1DryRun)
 }
 
 func resourceKubernetesRoleBindingV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -57,14 +60,9 @@ func resourceKubernetesRoleBindingV1Create(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	binding := &rbacv1.RoleBinding{
-		ObjectMeta: metadata,
-		RoleRef:    expandRBACRoleRef(d.Get("role_ref").([]interface{})),
-		Subjects:   expandRBACSubjects(d.Get("subject").([]interface{})),
-	}
+	binding := expandRoleBindingV1(d, meta)
 	log.Printf("[INFO] Creating new RoleBinding: %#v", binding)
-	out, err := conn.RbacV1().RoleBindings(metadata.Namespace).Create(ctx, binding, metav1.CreateOptions{})
+	out, err := createOrApply(ctx, meta, "rbac.authorization.k8s.io/v1", "RoleBinding", binding, conn.RbacV1().RoleBindings(binding.Namespace).Create)
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -75,6 +73,15 @@ func resourceKubernetesRoleBindingV1Create(ctx context.Context, d *schema.Resour
 	return resourceKubernetesRoleBindingV1Read(ctx, d, meta)
 }
 
+// expandRoleBindingV1 builds the role binding of d.
+func expandRoleBindingV1(d *schema.ResourceData, meta interface{}) *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+		RoleRef:    expandRBACRoleRef(d.Get("role_ref").([]interface{})),
+		Subjects:   expandRBACSubjects(d.Get("subject").([]interface{})),
+	}
+}
+
 func resourceKubernetesRoleBindingV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesRoleBindingV1Exists(ctx, d, meta)
 	if err != nil {
@@ -125,6 +132,10 @@ func resourceKubernetesRoleBindingV1Read(ctx context.Context, d *schema.Resource
 }
 
 func resourceKubernetesRoleBindingV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesRoleBindingV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -135,7 +146,7 @@ func resourceKubernetesRoleBindingV1Update(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("subject") {
 		diffOps := patchRbacSubject(d)
 		ops = append(ops, diffOps...)
@@ -155,7 +166,41 @@ func resourceKubernetesRoleBindingV1Update(ctx context.Context, d *schema.Resour
 	return resourceKubernetesRoleBindingV1Read(ctx, d, meta)
 }
 
+// resourceKubernetesRoleBindingV1Apply updates a RoleBinding with server-side apply.
+func resourceKubernetesRoleBindingV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	out, err := applyUpdate(ctx, d, meta, "rbac.authorization.k8s.io/v1", "RoleBinding", expandRoleBindingV1(d, meta), nil)
+	if err != nil {
+		return diag.Errorf("Failed to update RoleBinding: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated RoleBinding: %#v", out)
+
+	return resourceKubernetesRoleBindingV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesRoleBindingV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	binding := expandRoleBindingV1(d, meta)
+	client := conn.RbacV1().RoleBindings(binding.Namespace)
+	switch {
+	case !update:
+		_, err = client.Create(ctx, binding, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "rbac.authorization.k8s.io/v1", "RoleBinding", binding, nil)
+	default:
+		err = dryRunPatch(ctx, binding, client.Patch)
+	}
+	return err
+}
+
 func resourceKubernetesRoleBindingV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "rbac.authorization.k8s.io/v1", "RoleBinding")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -167,7 +212,7 @@ func resourceKubernetesRoleBindingV1Delete(ctx context.Context, d *schema.Resour
 	}
 
 	log.Printf("[INFO] Deleting RoleBinding: %#v", name)
-	err = conn.RbacV1().RoleBindings(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.RbacV1().RoleBindings(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
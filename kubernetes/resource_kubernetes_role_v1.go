@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,7 +18,7 @@ import (
 )
 
 func resourceKubernetesRoleV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "A role contains rules that represent a set of permissions. Permissions are purely additive (there are no “deny” rules).",
 		CreateContext: resourceKubernetesRoleV1Create,
 		ReadContext:   resourceKubernetesRoleV1Read,
@@ -28,7 +29,9 @@ func resourceKubernetesRoleV1() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": metadataSchemaRBAC("role", true, true),
+			"metadata":         metadataSchemaRBAC("role", true, true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"rule": {
 				Type:        schema.TypeList,
 				Description: "Rule defining a set of permissions for the role",
@@ -67,7 +70,7 @@ func resourceKubernetesRoleV1() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, resourceKubernetesRoleV1DryRun)
 }
 
 func resourceKubernetesRoleV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -76,15 +79,9 @@ func resourceKubernetesRoleV1Create(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	rules := expandRules(d.Get("rule").([]interface{}))
-
-	role := rbacv1.Role{
-		ObjectMeta: metadata,
-		Rules:      *rules,
-	}
+	role := expandRoleV1(d, meta)
 	log.Printf("[INFO] Creating new role: %#v", role)
-	out, err := conn.RbacV1().Roles(metadata.Namespace).Create(ctx, &role, metav1.CreateOptions{})
+	out, err := createOrApply(ctx, meta, "rbac.authorization.k8s.io/v1", "Role", role, conn.RbacV1().Roles(role.Namespace).Create)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -95,6 +92,14 @@ func resourceKubernetesRoleV1Create(ctx context.Context, d *schema.ResourceData,
 	return resourceKubernetesRoleV1Read(ctx, d, meta)
 }
 
+// expandRoleV1 builds the role of d.
+func expandRoleV1(d *schema.ResourceData, meta interface{}) *rbacv1.Role {
+	return &rbacv1.Role{
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+		Rules:      *expandRules(d.Get("rule").([]interface{})),
+	}
+}
+
 func resourceKubernetesRoleV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesRoleV1Exists(ctx, d, meta)
 	if err != nil {
@@ -136,6 +141,10 @@ func resourceKubernetesRoleV1Read(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceKubernetesRoleV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesRoleV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -146,7 +155,7 @@ func resourceKubernetesRoleV1Update(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("rule") {
 		rules := expandRules(d.Get("rule").([]interface{}))
 
@@ -171,7 +180,41 @@ func resourceKubernetesRoleV1Update(ctx context.Context, d *schema.ResourceData,
 	return resourceKubernetesRoleV1Read(ctx, d, meta)
 }
 
+// resourceKubernetesRoleV1Apply updates a role with server-side apply.
+func resourceKubernetesRoleV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	out, err := applyUpdate(ctx, d, meta, "rbac.authorization.k8s.io/v1", "Role", expandRoleV1(d, meta), nil)
+	if err != nil {
+		return diag.Errorf("Failed to update role: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated role: %#v", out)
+
+	return resourceKubernetesRoleV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesRoleV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	role := expandRoleV1(d, meta)
+	client := conn.RbacV1().Roles(role.Namespace)
+	switch {
+	case !update:
+		_, err = client.Create(ctx, role, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "rbac.authorization.k8s.io/v1", "Role", role, nil)
+	default:
+		err = dryRunPatch(ctx, role, client.Patch)
+	}
+	return err
+}
+
 func resourceKubernetesRoleV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "rbac.authorization.k8s.io/v1", "Role")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -183,7 +226,7 @@ func resourceKubernetesRoleV1Delete(ctx context.Context, d *schema.ResourceData,
 	}
 
 	log.Printf("[INFO] Deleting role: %#v", name)
-	err = conn.RbacV1().Roles(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.RbacV1().Roles(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
@@ -8,6 +8,7 @@ import (
 	"log"
 	"regexp"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,7 +21,7 @@ import (
 )
 
 func resourceKubernetesRuntimeClassV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "A runtime class is used to determine which container runtime is used to run all containers in a pod.",
 		CreateContext: resourceKubernetesRuntimeClassV1Create,
 		ReadContext:   resourceKubernetesRuntimeClassV1Read,
@@ -32,7 +33,9 @@ func resourceKubernetesRuntimeClassV1() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": metadataSchema("runtimeclass", true),
+			"metadata":         metadataSchema("runtimeclass", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 
 			"handler": {
 				Type:         schema.TypeString,
@@ -42,7 +45,7 @@ func resourceKubernetesRuntimeClassV1() *schema.Resource {
 				ForceNew:     true,
 			},
 		},
-	}
+	}, resourceKubernetesRuntimeClassV1DryRun)
 
 }
 
@@ -52,14 +55,7 @@ func resourceKubernetesRuntimeClassV1Create(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-
-	runtimeClass := nodev1.RuntimeClass{
-		ObjectMeta: metadata,
-		Handler:    d.Get("handler").(string),
-	}
-
-	out, err := conn.NodeV1().RuntimeClasses().Create(ctx, &runtimeClass, metav1.CreateOptions{})
+	out, err := createOrApply(ctx, meta, "node.k8s.io/v1", "RuntimeClass", expandRuntimeClassV1(d, meta), conn.NodeV1().RuntimeClasses().Create)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -70,6 +66,14 @@ func resourceKubernetesRuntimeClassV1Create(ctx context.Context, d *schema.Resou
 	return resourceKubernetesRuntimeClassV1Read(ctx, d, meta)
 }
 
+// expandRuntimeClassV1 builds the runtime class of d.
+func expandRuntimeClassV1(d *schema.ResourceData, meta interface{}) *nodev1.RuntimeClass {
+	return &nodev1.RuntimeClass{
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+		Handler:    d.Get("handler").(string),
+	}
+}
+
 func resourceKubernetesRuntimeClassV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesRuntimeClassV1Exists(ctx, d, meta)
 	if err != nil {
@@ -109,6 +113,10 @@ func resourceKubernetesRuntimeClassV1Read(ctx context.Context, d *schema.Resourc
 }
 
 func resourceKubernetesRuntimeClassV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesRuntimeClassV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -116,7 +124,7 @@ func resourceKubernetesRuntimeClassV1Update(ctx context.Context, d *schema.Resou
 
 	name := d.Id()
 
-	patch := patchMetadata("metadata.0.", "/metadata/", d)
+	patch := patchMetadata("metadata.0.", "/metadata/", d, meta)
 
 	data, err := patch.MarshalJSON()
 	if err != nil {
@@ -136,7 +144,41 @@ func resourceKubernetesRuntimeClassV1Update(ctx context.Context, d *schema.Resou
 	return resourceKubernetesRuntimeClassV1Read(ctx, d, meta)
 }
 
+// resourceKubernetesRuntimeClassV1Apply updates a runtime class with server-side apply.
+func resourceKubernetesRuntimeClassV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	out, err := applyUpdate(ctx, d, meta, "node.k8s.io/v1", "RuntimeClass", expandRuntimeClassV1(d, meta), nil)
+	if err != nil {
+		return diag.Errorf("Failed to update runtime class! API error: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated runtime class: %#v", out)
+
+	return resourceKubernetesRuntimeClassV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesRuntimeClassV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	runtimeClass := expandRuntimeClassV1(d, meta)
+	client := conn.NodeV1().RuntimeClasses()
+	switch {
+	case !update:
+		_, err = client.Create(ctx, runtimeClass, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "node.k8s.io/v1", "RuntimeClass", runtimeClass, nil)
+	default:
+		err = dryRunPatch(ctx, runtimeClass, client.Patch)
+	}
+	return err
+}
+
 func resourceKubernetesRuntimeClassV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "node.k8s.io/v1", "RuntimeClass")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -145,7 +187,7 @@ func resourceKubernetesRuntimeClassV1Delete(ctx context.Context, d *schema.Resou
 	name := d.Id()
 
 	log.Printf("[INFO] Deleting runtime class: %#v", name)
-	err = conn.NodeV1().RuntimeClasses().Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.NodeV1().RuntimeClasses().Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
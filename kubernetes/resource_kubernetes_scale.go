@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+func resourceKubernetesScale() *schema.Resource {
+	return &schema.Resource{
+		Description:   "This resource allows Terraform to manage the number of replicas of a resource that already exists, such as a Deployment, a StatefulSet or a custom resource with a `scale` subresource, while the rest of the resource is managed elsewhere. The replicas are read and updated through the [scale subresource](https://kubernetes.io/docs/tasks/access-kubernetes-api/custom-resources/custom-resource-definitions/#scale-subresource), like `kubectl scale` does. Destroying this resource leaves the number of replicas as it is.",
+		CreateContext: resourceKubernetesScaleCreate,
+		ReadContext:   resourceKubernetesScaleRead,
+		UpdateContext: resourceKubernetesScaleUpdate,
+		DeleteContext: resourceKubernetesScaleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"api_version": {
+				Type:        schema.TypeString,
+				Description: "The apiVersion of the resource to scale.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"kind": {
+				Type:        schema.TypeString,
+				Description: "The kind of the resource to scale.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the resource.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Description: "The namespace of the resource. The provider namespace is used when it is not set.",
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+			"replicas": {
+				Type:         schema.TypeInt,
+				Description:  "The desired number of replicas of the resource.",
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"wait_for_replicas": {
+				Type:        schema.TypeBool,
+				Description: "Wait until the number of replicas in the status of the resource matches `replicas`.",
+				Optional:    true,
+				Default:     false,
+			},
+			"field_manager": {
+				Type:         schema.TypeString,
+				Description:  "Set the name of the field manager for the replicas.",
+				Optional:     true,
+				Default:      defaultFieldManagerName,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		},
+	}
+}
+
+func resourceKubernetesScaleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	metadata, err := expandTargetMetadata(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(buildIdWithVersionKind(metadata,
+		d.Get("api_version").(string),
+		d.Get("kind").(string)))
+	diags := resourceKubernetesScaleUpdate(ctx, d, m)
+	if diags.HasError() {
+		d.SetId("")
+	}
+	return diags
+}
+
+// scaleResourceInterface returns the client for the resource targeted by the ID of d.
+func scaleResourceInterface(d *schema.ResourceData, m interface{}) (dynamic.ResourceInterface, string, error) {
+	gvk, name, namespace, err := util.ParseResourceID(d.Id(), util.DefaultNamespace)
+	if err != nil {
+		return nil, "", err
+	}
+	r, err := dynamicResourceInterface(m, gvk.GroupVersion().String(), gvk.Kind, namespace)
+	if err != nil {
+		return nil, "", err
+	}
+	return r, name, nil
+}
+
+func resourceKubernetesScaleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	r, name, err := scaleResourceInterface(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	scale, err := r.Get(ctx, name, v1.GetOptions{}, "scale")
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  "Resource deleted",
+				Detail:   fmt.Sprintf("The underlying resource %q has been deleted. You should recreate the underlying resource, or remove it from your configuration.", name),
+			}}
+		}
+		return diag.FromErr(err)
+	}
+
+	replicas, _, err := unstructured.NestedInt64(scale.Object, "spec", "replicas")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("replicas", replicas)
+	return nil
+}
+
+func resourceKubernetesScaleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	r, name, err := scaleResourceInterface(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	replicas := int64(d.Get("replicas").(int))
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+		},
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Scaling %s %q to %d replicas", d.Get("kind"), name, replicas)
+	_, err = r.Patch(ctx, name, types.MergePatchType, patch, v1.PatchOptions{
+		FieldManager: d.Get("field_manager").(string),
+	}, "scale")
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return diag.Errorf("The resource %q does not exist or has no scale subresource", name)
+		}
+		return diag.FromErr(err)
+	}
+
+	if d.Get("wait_for_replicas").(bool) {
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if d.IsNewResource() {
+			timeout = d.Timeout(schema.TimeoutCreate)
+		}
+		err = retry.RetryContext(ctx, timeout, retryUntilScaled(ctx, r, name, replicas))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesScaleRead(ctx, d, m)
+}
+
+func retryUntilScaled(ctx context.Context, r dynamic.ResourceInterface, name string, replicas int64) retry.RetryFunc {
+	return func() *retry.RetryError {
+		scale, err := r.Get(ctx, name, v1.GetOptions{}, "scale")
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		current, _, err := unstructured.NestedInt64(scale.Object, "status", "replicas")
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if current != replicas {
+			return retry.RetryableError(fmt.Errorf("Waiting for %q to have %d replicas, currently %d", name, replicas, current))
+		}
+		return nil
+	}
+}
+
+func resourceKubernetesScaleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// the replicas are left as they are
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesScale_deployment(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	namespace := "default"
+	resourceName := "kubernetes_scale.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			createDeployment(name, namespace)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			return destroyDeployment(name, namespace)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesScale(name, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "api_version", "apps/v1"),
+					resource.TestCheckResourceAttr(resourceName, "kind", "Deployment"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					// the provider namespace is resolved when the resource is created
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.namespace", namespace),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("apiVersion=apps/v1,kind=Deployment,name=%s,namespace=%s", name, namespace)),
+					resource.TestCheckResourceAttr(resourceName, "replicas", "2"),
+					testAccCheckKubernetesDeploymentReplicas(name, namespace, 2),
+				),
+			},
+			{
+				Config: testAccKubernetesScale(name, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "replicas", "0"),
+					testAccCheckKubernetesDeploymentReplicas(name, namespace, 0),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesDeploymentReplicas(name, namespace string, replicas int32) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		d, err := conn.AppsV1().Deployments(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if d.Spec.Replicas == nil || *d.Spec.Replicas != replicas {
+			return fmt.Errorf("expected %d replicas, got %v", replicas, d.Spec.Replicas)
+		}
+		return nil
+	}
+}
+
+func testAccKubernetesScale(name string, replicas int) string {
+	return fmt.Sprintf(`resource "kubernetes_scale" "test" {
+  api_version = "apps/v1"
+  kind        = "Deployment"
+  metadata {
+    name = %q
+  }
+  replicas          = %d
+  wait_for_replicas = true
+}
+`, name, replicas)
+}
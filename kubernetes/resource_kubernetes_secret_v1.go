@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,7 +25,7 @@ import (
 )
 
 func resourceKubernetesSecretV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "The resource provides mechanisms to inject containers with sensitive information, such as passwords, while keeping containers agnostic of Kubernetes. Secrets can be used to store sensitive information either as individual properties or coarse-grained entries like entire files or JSON blobs. The resource will by default create a secret which is available to any pod in the specified (or default) namespace.",
 		CreateContext: resourceKubernetesSecretV1Create,
 		ReadContext:   resourceKubernetesSecretV1Read,
@@ -58,7 +59,9 @@ func resourceKubernetesSecretV1() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": namespacedMetadataSchema("secret", true),
+			"metadata":         namespacedMetadataSchema("secret", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"data": {
 				Type:        schema.TypeMap,
 				Description: "A map of the secret data.",
@@ -131,7 +134,7 @@ func resourceKubernetesSecretV1() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
 		},
-	}
+	}, resourceKubernetesSecretV1DryRun)
 }
 
 func resourceKubernetesSecretV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -140,15 +143,62 @@ func resourceKubernetesSecretV1Create(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	secret := corev1.Secret{
+	secret, diags := expandSecretV1(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[INFO] Creating new secret: %#v", secret)
+	out, err := createOrApply(ctx, meta, "v1", "Secret", secret, conn.CoreV1().Secrets(secret.Namespace).Create)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Submitting new secret: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	if out.Type == corev1.SecretTypeServiceAccountToken && d.Get("wait_for_service_account_token").(bool) {
+		log.Printf("[DEBUG] Waiting for secret service account token to be created")
+
+		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+			secret, err := conn.CoreV1().Secrets(out.Namespace).Get(ctx, out.Name, metav1.GetOptions{})
+			if err != nil {
+				log.Printf("[DEBUG] Received error: %#v", err)
+				return retry.NonRetryableError(err)
+			}
+
+			log.Printf("[INFO] Received secret: %#v", secret.Name)
+			if _, ok := secret.Data["token"]; ok {
+				log.Println("[INFO] Secret service account token created")
+				return nil
+			}
+
+			return retry.RetryableError(fmt.Errorf(
+				"Waiting for secret %q to create service account token", d.Id()))
+		})
+		if err != nil {
+			lastWarnings, wErr := getLastWarningsForObject(ctx, conn, out.ObjectMeta, "Secret", 3)
+			if wErr != nil {
+				return diag.FromErr(wErr)
+			}
+			return diag.Errorf("%s%s", err, util.StringifyEvents(lastWarnings))
+		}
+	}
+
+	return resourceKubernetesSecretV1Read(ctx, d, meta)
+}
+
+// expandSecretV1 builds the secret of d.
+func expandSecretV1(d *schema.ResourceData, meta interface{}) (*corev1.Secret, diag.Diagnostics) {
+	metadata := expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
+	secret := &corev1.Secret{
 		ObjectMeta: metadata,
 	}
 
 	if datarev, ok := d.Get("data_wo_revision").(int); ok && datarev >= 1 {
 		wodata, diags := d.GetRawConfigAt(cty.GetAttrPath("data_wo"))
 		if diags.HasError() {
-			return diags
+			return nil, diags
 		}
 		if wodata.IsWhollyKnown() && !wodata.IsNull() {
 			secret.StringData = expandCtyStringMap(wodata.AsValueMap())
@@ -165,7 +215,7 @@ func resourceKubernetesSecretV1Create(ctx context.Context, d *schema.ResourceDat
 	if bindatarev, ok := d.Get("binary_data_wo_revision").(int); ok && bindatarev >= 1 {
 		wobindata, diags := d.GetRawConfigAt(cty.GetAttrPath("binary_data_wo"))
 		if diags.HasError() {
-			return diags
+			return nil, diags
 		}
 		if wobindata.IsWhollyKnown() && !wobindata.IsNull() {
 			secret.Data = expandCtyBase64MapToByteMap(wobindata.AsValueMap())
@@ -173,7 +223,7 @@ func resourceKubernetesSecretV1Create(ctx context.Context, d *schema.ResourceDat
 	} else if v, ok := d.GetOk("binary_data"); ok {
 		m, err := base64DecodeStringMap(v.(map[string]interface{}))
 		if err != nil {
-			return diag.FromErr(err)
+			return nil, diag.FromErr(err)
 		}
 		secret.Data = m
 	}
@@ -186,44 +236,17 @@ func resourceKubernetesSecretV1Create(ctx context.Context, d *schema.ResourceDat
 		secret.Immutable = ptr.To(v.(bool))
 	}
 
-	log.Printf("[INFO] Creating new secret: %#v", secret)
-	out, err := conn.CoreV1().Secrets(metadata.Namespace).Create(ctx, &secret, metav1.CreateOptions{})
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	log.Printf("[INFO] Submitting new secret: %#v", out)
-	d.SetId(buildId(out.ObjectMeta))
-
-	if out.Type == corev1.SecretTypeServiceAccountToken && d.Get("wait_for_service_account_token").(bool) {
-		log.Printf("[DEBUG] Waiting for secret service account token to be created")
-
-		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
-			secret, err := conn.CoreV1().Secrets(out.Namespace).Get(ctx, out.Name, metav1.GetOptions{})
-			if err != nil {
-				log.Printf("[DEBUG] Received error: %#v", err)
-				return retry.NonRetryableError(err)
-			}
-
-			log.Printf("[INFO] Received secret: %#v", secret.Name)
-			if _, ok := secret.Data["token"]; ok {
-				log.Println("[INFO] Secret service account token created")
-				return nil
-			}
-
-			return retry.RetryableError(fmt.Errorf(
-				"Waiting for secret %q to create service account token", d.Id()))
-		})
-		if err != nil {
-			lastWarnings, wErr := getLastWarningsForObject(ctx, conn, out.ObjectMeta, "Secret", 3)
-			if wErr != nil {
-				return diag.FromErr(wErr)
-			}
-			return diag.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+	if serverSideApplyEnabled(meta) && len(secret.StringData) > 0 {
+		// stringData is not persisted, apply data so that the keys are owned by the field manager
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
 		}
+		for k, v := range secret.StringData {
+			secret.Data[k] = []byte(v)
+		}
+		secret.StringData = nil
 	}
-
-	return resourceKubernetesSecretV1Read(ctx, d, meta)
+	return secret, nil
 }
 
 func resourceKubernetesSecretV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -287,6 +310,10 @@ func resourceKubernetesSecretV1Read(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceKubernetesSecretV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesSecretV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -297,7 +324,7 @@ func resourceKubernetesSecretV1Update(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 
 	newData := map[string]interface{}{}
 	updateData := false
@@ -382,7 +409,60 @@ func resourceKubernetesSecretV1Update(ctx context.Context, d *schema.ResourceDat
 	return resourceKubernetesSecretV1Read(ctx, d, meta)
 }
 
+// resourceKubernetesSecretV1Apply updates a secret with server-side apply.
+func resourceKubernetesSecretV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, diags := expandSecretV1(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	omitSecretV1ReadData(d, d.GetRawConfig(), secret)
+	out, err := applyUpdate(ctx, d, meta, "v1", "Secret", secret, nil)
+	if err != nil {
+		return diag.Errorf("Failed to update secret: %s", err)
+	}
+	log.Printf("[INFO] Submitting updated secret: %#v", out.ObjectMeta)
+
+	return resourceKubernetesSecretV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesSecretV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	secret, diags := expandSecretV1(d, meta)
+	if diags.HasError() {
+		return fmt.Errorf("%s", diags[0].Summary)
+	}
+	client := conn.CoreV1().Secrets(secret.Namespace)
+	switch {
+	case !update:
+		_, err = client.Create(ctx, secret, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		omitSecretV1ReadData(d, config, secret)
+		err = dryRunApply(ctx, meta, config, "v1", "Secret", secret, nil)
+	default:
+		err = dryRunPatch(ctx, secret, client.Patch)
+	}
+	return err
+}
+
+// omitSecretV1ReadData leaves the data read from the secret, like a service
+// account token, to the controller that wrote it when config does not set it.
+func omitSecretV1ReadData(d *schema.ResourceData, config cty.Value, secret *corev1.Secret) {
+	if v, _ := d.Get("data_wo_revision").(int); v < 1 && !attributeConfigured(config, "data") {
+		for k := range d.Get("data").(map[string]interface{}) {
+			delete(secret.Data, k)
+		}
+	}
+}
+
 func resourceKubernetesSecretV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "v1", "Secret")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -394,7 +474,7 @@ func resourceKubernetesSecretV1Delete(ctx context.Context, d *schema.ResourceDat
 	}
 
 	log.Printf("[INFO] Deleting secret: %q", name)
-	err = conn.CoreV1().Secrets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().Secrets(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
@@ -43,8 +43,8 @@ func resourceKubernetesSecretV1Data() *schema.Resource {
 							Type:        schema.TypeString,
 							Description: "The namespace of the Secret.",
 							Optional:    true,
+							Computed:    true,
 							ForceNew:    true,
-							Default:     "default",
 						},
 					},
 				},
@@ -73,7 +73,14 @@ func resourceKubernetesSecretV1Data() *schema.Resource {
 }
 
 func resourceKubernetesSecretV1DataCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	metadata := expandMetadata(d.Get("metadata").([]any))
+	metadata := expandMetadataWithNamespace(d.Get("metadata").([]any), m)
+	err := d.Set("metadata", []any{map[string]any{
+		"name":      metadata.Name,
+		"namespace": metadata.Namespace,
+	}})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	// Sets the resource id based on the metadata
 	d.SetId(buildId(metadata))
 
@@ -38,7 +38,9 @@ func resourceKubernetesServiceAccountV1() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": namespacedMetadataSchema("service account", true),
+			"metadata":         namespacedMetadataSchema("service account", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"image_pull_secret": {
 				Type:        schema.TypeSet,
 				Description: "A list of references to secrets in the same namespace to use for pulling any images in pods that reference this Service Account. More info: https://kubernetes.io/docs/concepts/containers/images/#specifying-imagepullsecrets-on-a-pod",
@@ -88,7 +90,7 @@ func resourceKubernetesServiceAccountV1Create(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta)
 	svcAcc := corev1.ServiceAccount{
 		AutomountServiceAccountToken: ptr.To(d.Get("automount_service_account_token").(bool)),
 		ObjectMeta:                   metadata,
@@ -321,7 +323,7 @@ func resourceKubernetesServiceAccountV1Update(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("image_pull_secret") {
 		v := d.Get("image_pull_secret").(*schema.Set).List()
 		ops = append(ops, &ReplaceOperation{
@@ -361,6 +363,10 @@ func resourceKubernetesServiceAccountV1Update(ctx context.Context, d *schema.Res
 }
 
 func resourceKubernetesServiceAccountV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "v1", "ServiceAccount")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -372,7 +378,7 @@ func resourceKubernetesServiceAccountV1Delete(ctx context.Context, d *schema.Res
 	}
 
 	log.Printf("[INFO] Deleting service account: %#v", name)
-	err = conn.CoreV1().ServiceAccounts(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().ServiceAccounts(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
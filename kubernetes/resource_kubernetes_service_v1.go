@@ -9,11 +9,13 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -21,7 +23,7 @@ import (
 )
 
 func resourceKubernetesServiceV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "A Service is an abstraction which defines a logical set of pods and a policy by which to access them - sometimes called a micro-service.",
 		CreateContext: resourceKubernetesServiceV1Create,
 		ReadContext:   resourceKubernetesServiceV1Read,
@@ -43,12 +45,15 @@ func resourceKubernetesServiceV1() *schema.Resource {
 			},
 		},
 		Schema: resourceKubernetesServiceSchemaV1(),
-	}
+	}, resourceKubernetesServiceV1DryRun)
 }
 
 func resourceKubernetesServiceSchemaV1() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
-		"metadata": namespacedMetadataSchema("service", true),
+		"metadata":         namespacedMetadataSchema("service", true),
+		"delete_options":   deleteOptionsSchema(),
+		"destroy_behavior": destroyBehaviorSchema(),
+		"wait_for_ready":   waitForReadySchema(),
 		"spec": {
 			Type:        schema.TypeList,
 			Description: "Spec defines the behavior of a service. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status",
@@ -338,13 +343,9 @@ func resourceKubernetesServiceV1Create(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	svc := corev1.Service{
-		ObjectMeta: metadata,
-		Spec:       expandServiceSpec(d.Get("spec").([]interface{})),
-	}
+	svc := expandServiceV1(d, meta)
 	log.Printf("[INFO] Creating new service: %#v", svc)
-	out, err := conn.CoreV1().Services(metadata.Namespace).Create(ctx, &svc, metav1.CreateOptions{})
+	out, err := createOrApply(ctx, meta, "v1", "Service", svc, conn.CoreV1().Services(svc.Namespace).Create)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -376,13 +377,25 @@ func resourceKubernetesServiceV1Create(ctx context.Context, d *schema.ResourceDa
 			if wErr != nil {
 				return diag.FromErr(wErr)
 			}
-			return diag.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+			return diag.Errorf("%s%s", err, util.StringifyEvents(lastWarnings))
 		}
 	}
 
+	if diags := waitForObjectReady(ctx, d, meta, "v1", "Service", out, schema.TimeoutCreate); diags.HasError() {
+		return diags
+	}
+
 	return resourceKubernetesServiceV1Read(ctx, d, meta)
 }
 
+// expandServiceV1 builds the service of d.
+func expandServiceV1(d *schema.ResourceData, meta interface{}) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+		Spec:       expandServiceSpec(d.Get("spec").([]interface{})),
+	}
+}
+
 func resourceKubernetesServiceV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesServiceV1Exists(ctx, d, meta)
 	if err != nil {
@@ -434,6 +447,10 @@ func resourceKubernetesServiceV1Read(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceKubernetesServiceV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesServiceV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -444,7 +461,7 @@ func resourceKubernetesServiceV1Update(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("spec") {
 		serverVersion, err := getServerVersion(conn)
 		if err != nil {
@@ -465,10 +482,66 @@ func resourceKubernetesServiceV1Update(ctx context.Context, d *schema.ResourceDa
 	log.Printf("[INFO] Submitted updated service: %#v", out)
 	d.SetId(buildId(out.ObjectMeta))
 
+	if diags := waitForObjectReady(ctx, d, meta, "v1", "Service", out, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
+	return resourceKubernetesServiceV1Read(ctx, d, meta)
+}
+
+// serviceV1ComputedFields are the fields left out of a server-side apply
+// update when their attribute is not configured, like the cluster IP
+// allocated by the API server.
+var serviceV1ComputedFields = map[string][]string{
+	"spec.0.cluster_ip":              {"spec", "clusterIP"},
+	"spec.0.cluster_ips":             {"spec", "clusterIPs"},
+	"spec.0.external_traffic_policy": {"spec", "externalTrafficPolicy"},
+	"spec.0.health_check_node_port":  {"spec", "healthCheckNodePort"},
+	"spec.0.internal_traffic_policy": {"spec", "internalTrafficPolicy"},
+	"spec.0.ip_families":             {"spec", "ipFamilies"},
+	"spec.0.ip_family_policy":        {"spec", "ipFamilyPolicy"},
+	"spec.0.session_affinity_config": {"spec", "sessionAffinityConfig"},
+}
+
+// resourceKubernetesServiceV1Apply updates a service with server-side apply.
+func resourceKubernetesServiceV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	out, err := applyUpdate(ctx, d, meta, "v1", "Service", expandServiceV1(d, meta), serviceV1ComputedFields)
+	if err != nil {
+		return diag.Errorf("Failed to update service: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated service: %#v", out)
+
+	if diags := waitForObjectReady(ctx, d, meta, "v1", "Service", out, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
 	return resourceKubernetesServiceV1Read(ctx, d, meta)
 }
 
+func resourceKubernetesServiceV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	svc := expandServiceV1(d, meta)
+	client := conn.CoreV1().Services(svc.Namespace)
+	switch {
+	case !update:
+		_, err = client.Create(ctx, svc, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "v1", "Service", svc, serviceV1ComputedFields)
+	default:
+		err = dryRunPatch(ctx, svc, client.Patch)
+	}
+	return err
+}
+
 func resourceKubernetesServiceV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "v1", "Service")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -480,7 +553,7 @@ func resourceKubernetesServiceV1Delete(ctx context.Context, d *schema.ResourceDa
 	}
 
 	log.Printf("[INFO] Deleting service: %#v", name)
-	err = conn.CoreV1().Services(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.CoreV1().Services(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -24,7 +25,7 @@ import (
 )
 
 func resourceKubernetesStatefulSetV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "Manages the deployment and scaling of a set of Pods , and provides guarantees about the ordering and uniqueness of these Pods. Like a Deployment , a StatefulSet manages Pods that are based on an identical container spec. Unlike a Deployment, a StatefulSet maintains a sticky identity for each of their Pods. These pods are created from the same spec, but are not interchangeable: each has a persistent identifier that it maintains across any rescheduling. A StatefulSet operates under the same pattern as any other Controller. You define your desired state in a StatefulSet object, and the StatefulSet controller makes any necessary updates to get there from the current state.",
 		CreateContext: resourceKubernetesStatefulSetV1Create,
 		ReadContext:   resourceKubernetesStatefulSetV1Read,
@@ -48,12 +49,15 @@ func resourceKubernetesStatefulSetV1() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: resourceKubernetesStatefulSetSchemaV1(),
-	}
+	}, resourceKubernetesStatefulSetV1DryRun)
 }
 
 func resourceKubernetesStatefulSetSchemaV1() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
-		"metadata": namespacedMetadataSchema("stateful set", true),
+		"metadata":         namespacedMetadataSchema("stateful set", true),
+		"delete_options":   deleteOptionsSchema(),
+		"destroy_behavior": destroyBehaviorSchema(),
+		"wait_for_ready":   waitForReadySchema(),
 		"spec": {
 			Type:        schema.TypeList,
 			Description: "Spec defines the desired identities of pods in this set.",
@@ -70,6 +74,7 @@ func resourceKubernetesStatefulSetSchemaV1() map[string]*schema.Schema {
 			Default:     true,
 			Optional:    true,
 		},
+		"restart_triggers": restartTriggersSchema(),
 	}
 }
 
@@ -79,18 +84,13 @@ func resourceKubernetesStatefulSetV1Create(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandStatefulSetSpec(d.Get("spec").([]interface{}))
+	statefulSet, err := expandStatefulSetV1(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	statefulSet := appsv1.StatefulSet{
-		ObjectMeta: metadata,
-		Spec:       *spec,
-	}
 	log.Printf("[INFO] Creating new StatefulSet: %#v", statefulSet)
 
-	out, err := conn.AppsV1().StatefulSets(metadata.Namespace).Create(ctx, &statefulSet, metav1.CreateOptions{})
+	out, err := createOrApply(ctx, meta, "apps/v1", "StatefulSet", statefulSet, conn.AppsV1().StatefulSets(statefulSet.Namespace).Create)
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -109,13 +109,28 @@ func resourceKubernetesStatefulSetV1Create(ctx context.Context, d *schema.Resour
 		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
 			retryUntilStatefulSetRolloutComplete(ctx, conn, namespace, name))
 		if err != nil {
-			return diag.FromErr(err)
+			return diag.FromErr(describeWorkloadFailure(ctx, conn, "StatefulSet", namespace, name, err))
 		}
 	}
 
+	if diags := waitForObjectReady(ctx, d, meta, "apps/v1", "StatefulSet", out, schema.TimeoutCreate); diags.HasError() {
+		return diags
+	}
+
 	return resourceKubernetesStatefulSetV1Read(ctx, d, meta)
 }
 
+func expandStatefulSetV1(d *schema.ResourceData, meta interface{}) (*appsv1.StatefulSet, error) {
+	spec, err := expandStatefulSetSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &appsv1.StatefulSet{
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
+		Spec:       *spec,
+	}, nil
+}
+
 func resourceKubernetesStatefulSetV1Exists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
@@ -174,6 +189,7 @@ func resourceKubernetesStatefulSetV1Read(ctx context.Context, d *schema.Resource
 	if d.Set("metadata", flattenMetadata(statefulSet.ObjectMeta, d, meta)) != nil {
 		return diag.Errorf("Error setting `metadata`: %+v", err)
 	}
+	hideRestartedAt(d, &statefulSet.Spec.Template)
 	sss, err := flattenStatefulSetSpec(statefulSet.Spec, d, meta)
 	if err != nil {
 		return diag.Errorf("Error flattening `spec`: %+v", err)
@@ -186,6 +202,10 @@ func resourceKubernetesStatefulSetV1Read(ctx context.Context, d *schema.Resource
 }
 
 func resourceKubernetesStatefulSetV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesStatefulSetV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -195,11 +215,15 @@ func resourceKubernetesStatefulSetV1Update(ctx context.Context, d *schema.Resour
 	if err != nil {
 		return diag.Errorf("Error parsing resource ID: %#v", err)
 	}
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 
-	if d.HasChange("spec") {
+	if d.HasChange("spec") || d.HasChange("restart_triggers") {
 		log.Println("[TRACE] StatefulSet.Spec has changes")
-		specPatch, err := patchStatefulSetSpec(d)
+		live, err := conn.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		specPatch, err := patchStatefulSetSpec(d, live.Spec.Template)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -222,15 +246,94 @@ func resourceKubernetesStatefulSetV1Update(ctx context.Context, d *schema.Resour
 		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
 			retryUntilStatefulSetRolloutComplete(ctx, conn, namespace, name))
 		if err != nil {
-			return diag.FromErr(err)
+			return diag.FromErr(describeWorkloadFailure(ctx, conn, "StatefulSet", namespace, name, err))
 		}
 		return diag.Diagnostics{}
 	}
 
+	if diags := waitForObjectReady(ctx, d, meta, "apps/v1", "StatefulSet", out, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
 	return resourceKubernetesStatefulSetV1Read(ctx, d, meta)
 }
 
+// statefulSetV1ComputedFields are the fields left out of a server-side apply
+// update when their attribute is not configured.
+var statefulSetV1ComputedFields = map[string][]string{
+	"spec.0.replicas":                                 {"spec", "replicas"},
+	"spec.0.revision_history_limit":                   {"spec", "revisionHistoryLimit"},
+	"spec.0.pod_management_policy":                    {"spec", "podManagementPolicy"},
+	"spec.0.persistent_volume_claim_retention_policy": {"spec", "persistentVolumeClaimRetentionPolicy"},
+}
+
+// resourceKubernetesStatefulSetV1Apply updates a StatefulSet with server-side
+// apply, or validates the update with a server dry-run.
+func resourceKubernetesStatefulSetV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statefulSet, err := expandStatefulSetV1(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	live, err := conn.AppsV1().StatefulSets(statefulSet.Namespace).Get(ctx, statefulSet.Name, metav1.GetOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	restartPodTemplate(d, &statefulSet.Spec.Template, live.Spec.Template)
+
+	out, err := applyUpdate(ctx, d, meta, "apps/v1", "StatefulSet", statefulSet, statefulSetV1ComputedFields)
+	if err != nil {
+		return diag.Errorf("Failed to update StatefulSet: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated StatefulSet: %#v", out)
+
+	if d.Get("wait_for_rollout").(bool) {
+		log.Printf("[INFO] Waiting for StatefulSet %s to rollout", d.Id())
+		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
+			retryUntilStatefulSetRolloutComplete(ctx, conn, out.Namespace, out.Name))
+		if err != nil {
+			return diag.FromErr(describeWorkloadFailure(ctx, conn, "StatefulSet", out.Namespace, out.Name, err))
+		}
+	}
+
+	if diags := waitForObjectReady(ctx, d, meta, "apps/v1", "StatefulSet", out, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
+	return resourceKubernetesStatefulSetV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesStatefulSetV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	statefulSet, err := expandStatefulSetV1(d, meta)
+	if err != nil {
+		return err
+	}
+	client := conn.AppsV1().StatefulSets(statefulSet.Namespace)
+	switch {
+	case !update:
+		_, err = client.Create(ctx, statefulSet, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "apps/v1", "StatefulSet", statefulSet, statefulSetV1ComputedFields)
+	default:
+		err = dryRunPatch(ctx, statefulSet, client.Patch)
+	}
+	return err
+}
+
 func resourceKubernetesStatefulSetV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "apps/v1", "StatefulSet")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -241,7 +344,7 @@ func resourceKubernetesStatefulSetV1Delete(ctx context.Context, d *schema.Resour
 		return diag.Errorf("Error parsing resource ID: %#v", err)
 	}
 	log.Printf("[INFO] Deleting StatefulSet: %#v", name)
-	err = conn.AppsV1().StatefulSets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.AppsV1().StatefulSets(namespace).Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
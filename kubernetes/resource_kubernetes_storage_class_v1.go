@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -21,7 +22,7 @@ import (
 )
 
 func resourceKubernetesStorageClassV1() *schema.Resource {
-	return &schema.Resource{
+	return withServerDryRun(&schema.Resource{
 		Description:   "Storage class is the foundation of dynamic provisioning, allowing cluster administrators to define abstractions for the underlying storage platform. Read more [here] (https://kubernetes.io/blog/2017/03/dynamic-provisioning-and-storage-classes-kubernetes/)",
 		CreateContext: resourceKubernetesStorageClassV1Create,
 		ReadContext:   resourceKubernetesStorageClassV1Read,
@@ -32,7 +33,9 @@ func resourceKubernetesStorageClassV1() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": metadataSchema("storage class", true),
+			"metadata":         metadataSchema("storage class", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"parameters": {
 				Type:        schema.TypeMap,
 				Description: "The parameters for the provisioner that should create volumes of this storage class",
@@ -113,7 +116,7 @@ func resourceKubernetesStorageClassV1() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, resourceKubernetesStorageClassV1DryRun)
 }
 
 func resourceKubernetesStorageClassV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -122,12 +125,25 @@ func resourceKubernetesStorageClassV1Create(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	storageClass := expandStorageClassV1(d, meta)
+	log.Printf("[INFO] Creating new storage class: %#v", storageClass)
+	out, err := createOrApply(ctx, meta, "storage.k8s.io/v1", "StorageClass", storageClass, conn.StorageV1().StorageClasses().Create)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Submitted new storage class: %#v", out)
+	d.SetId(out.Name)
+
+	return resourceKubernetesStorageClassV1Read(ctx, d, meta)
+}
+
+// expandStorageClassV1 builds the storage class of d.
+func expandStorageClassV1(d *schema.ResourceData, meta interface{}) *api.StorageClass {
 	reclaimPolicy := v1.PersistentVolumeReclaimPolicy(d.Get("reclaim_policy").(string))
 	volumeBindingMode := api.VolumeBindingMode(d.Get("volume_binding_mode").(string))
 	allowVolumeExpansion := d.Get("allow_volume_expansion").(bool)
-	storageClass := api.StorageClass{
-		ObjectMeta:           metadata,
+	storageClass := &api.StorageClass{
+		ObjectMeta:           expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
 		Provisioner:          d.Get("storage_provisioner").(string),
 		ReclaimPolicy:        &reclaimPolicy,
 		VolumeBindingMode:    &volumeBindingMode,
@@ -144,16 +160,7 @@ func resourceKubernetesStorageClassV1Create(ctx context.Context, d *schema.Resou
 	if v, ok := d.GetOk("allowed_topologies"); ok && len(v.([]interface{})) > 0 {
 		storageClass.AllowedTopologies = expandStorageClassAllowedTopologies(v.([]interface{}))
 	}
-
-	log.Printf("[INFO] Creating new storage class: %#v", storageClass)
-	out, err := conn.StorageV1().StorageClasses().Create(ctx, &storageClass, metav1.CreateOptions{})
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	log.Printf("[INFO] Submitted new storage class: %#v", out)
-	d.SetId(out.Name)
-
-	return resourceKubernetesStorageClassV1Read(ctx, d, meta)
+	return storageClass
 }
 
 func resourceKubernetesStorageClassV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -198,13 +205,17 @@ func resourceKubernetesStorageClassV1Read(ctx context.Context, d *schema.Resourc
 }
 
 func resourceKubernetesStorageClassV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if serverSideApplyEnabled(meta) {
+		return resourceKubernetesStorageClassV1Apply(ctx, d, meta)
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	name := d.Id()
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 
 	if d.HasChange("allow_volume_expansion") {
 		newVal := d.Get("allow_volume_expansion").(bool)
@@ -229,7 +240,41 @@ func resourceKubernetesStorageClassV1Update(ctx context.Context, d *schema.Resou
 	return resourceKubernetesStorageClassV1Read(ctx, d, meta)
 }
 
+// resourceKubernetesStorageClassV1Apply updates a storage class with server-side apply.
+func resourceKubernetesStorageClassV1Apply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	out, err := applyUpdate(ctx, d, meta, "storage.k8s.io/v1", "StorageClass", expandStorageClassV1(d, meta), nil)
+	if err != nil {
+		return diag.Errorf("Failed to update storage class: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated storage class: %#v", out)
+
+	return resourceKubernetesStorageClassV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesStorageClassV1DryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, update bool, config cty.Value) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	storageClass := expandStorageClassV1(d, meta)
+	client := conn.StorageV1().StorageClasses()
+	switch {
+	case !update:
+		_, err = client.Create(ctx, storageClass, metav1.CreateOptions{DryRun: dryRunOptions})
+	case serverSideApplyEnabled(meta):
+		err = dryRunApply(ctx, meta, config, "storage.k8s.io/v1", "StorageClass", storageClass, nil)
+	default:
+		err = dryRunPatch(ctx, storageClass, client.Patch)
+	}
+	return err
+}
+
 func resourceKubernetesStorageClassV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "storage.k8s.io/v1", "StorageClass")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -237,7 +282,7 @@ func resourceKubernetesStorageClassV1Delete(ctx context.Context, d *schema.Resou
 
 	name := d.Id()
 	log.Printf("[INFO] Deleting storage class: %#v", name)
-	err = conn.StorageV1().StorageClasses().Delete(ctx, name, metav1.DeleteOptions{})
+	err = conn.StorageV1().StorageClasses().Delete(ctx, name, expandDeleteOptions(d, meta))
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
@@ -50,7 +50,7 @@ func resourceKubernetesTokenRequestV1Create(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadataWithNamespace(d.Get("metadata").([]interface{}), meta)
 	spec := expandTokenRequestV1Spec(d.Get("spec").([]interface{}))
 	saName := d.Get("metadata.0.name").(string)
 
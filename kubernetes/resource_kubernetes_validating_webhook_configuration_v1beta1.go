@@ -33,7 +33,9 @@ func resourceKubernetesValidatingWebhookConfigurationV1Beta1() *schema.Resource
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": metadataSchema("validating webhook configuration", true),
+			"metadata":         metadataSchema("validating webhook configuration", true),
+			"delete_options":   deleteOptionsSchema(),
+			"destroy_behavior": destroyBehaviorSchema(),
 			"webhook": {
 				Type:        schema.TypeList,
 				Description: apiDoc["webhooks"],
@@ -139,7 +141,7 @@ func resourceKubernetesValidatingWebhookConfigurationV1Beta1Create(ctx context.C
 	}
 
 	cfg := admissionregistrationv1.ValidatingWebhookConfiguration{
-		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		ObjectMeta: expandMetadataWithDefaults(d.Get("metadata").([]interface{}), meta),
 		Webhooks:   expandValidatingWebhooks(d.Get("webhook").([]interface{})),
 	}
 
@@ -227,7 +229,7 @@ func resourceKubernetesValidatingWebhookConfigurationV1Beta1Update(ctx context.C
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 
 	if d.HasChange("webhook") {
 		op := &ReplaceOperation{
@@ -282,6 +284,10 @@ func resourceKubernetesValidatingWebhookConfigurationV1Beta1Update(ctx context.C
 }
 
 func resourceKubernetesValidatingWebhookConfigurationV1Beta1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if shouldAbandon(d) {
+		return abandonResource(ctx, d, meta, "admissionregistration.k8s.io/v1", "ValidatingWebhookConfiguration")
+	}
+
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
 		return diag.FromErr(err)
@@ -295,9 +301,9 @@ func resourceKubernetesValidatingWebhookConfigurationV1Beta1Delete(ctx context.C
 		return diag.FromErr(err)
 	}
 	if useadmissionregistrationv1beta1 {
-		err = conn.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Delete(ctx, name, metav1.DeleteOptions{})
+		err = conn.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Delete(ctx, name, expandDeleteOptions(d, meta))
 	} else {
-		err = conn.AdmissionregistrationV1().ValidatingWebhookConfigurations().Delete(ctx, name, metav1.DeleteOptions{})
+		err = conn.AdmissionregistrationV1().ValidatingWebhookConfigurations().Delete(ctx, name, expandDeleteOptions(d, meta))
 	}
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+// restartedAtAnnotation is the pod template annotation `kubectl rollout restart`
+// sets to restart the pods of a workload.
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// deploymentRevisionAnnotation holds the revision of a deployment and of its ReplicaSets.
+const deploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+
+func restartTriggersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Description: "Arbitrary values that restart the pods when they change, like `kubectl rollout restart` does. Typically the checksum of a ConfigMap or Secret the pods read.",
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+func waitForReadySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Wait for the resource to be ready, as computed by kstatus: the latest generation is observed, the status of built-in workloads shows the rollout is done, and the `Ready` or `Available` condition of other resources is `True`.",
+		Optional:    true,
+		Default:     false,
+	}
+}
+
+// restartPodTemplate sets the restartedAt annotation on the pod template of an
+// updated workload: to the current time when `restart_triggers` changed, or to
+// the value of the live pod template otherwise, so that replacing the pod
+// template does not restart the pods again.
+func restartPodTemplate(d *schema.ResourceData, template *corev1.PodTemplateSpec, live corev1.PodTemplateSpec) {
+	if _, ok := template.Annotations[restartedAtAnnotation]; ok {
+		// set in the configuration
+		return
+	}
+	v := live.Annotations[restartedAtAnnotation]
+	if d.HasChange("restart_triggers") {
+		v = time.Now().Format(time.RFC3339)
+	}
+	if v == "" {
+		return
+	}
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[restartedAtAnnotation] = v
+}
+
+// hideRestartedAt removes the restartedAt annotation from a pod template read
+// from the API, unless it is configured, as it changes on every restart.
+func hideRestartedAt(d *schema.ResourceData, template *corev1.PodTemplateSpec) {
+	configured, _ := d.Get("spec.0.template.0.metadata.0.annotations").(map[string]interface{})
+	if !isKeyInMap(restartedAtAnnotation, configured) {
+		delete(template.Annotations, restartedAtAnnotation)
+	}
+}
+
+// previousReplicaSet returns the ReplicaSet of the revision of deployment
+// before the latest one, along with its revision.
+func previousReplicaSet(deployment *appsv1.Deployment, replicaSets []appsv1.ReplicaSet) (*appsv1.ReplicaSet, int64) {
+	var latest, previous int64
+	var latestRS, previousRS *appsv1.ReplicaSet
+	for i := range replicaSets {
+		rs := &replicaSets[i]
+		if !metav1.IsControlledBy(rs, deployment) {
+			continue
+		}
+		rev, err := strconv.ParseInt(rs.Annotations[deploymentRevisionAnnotation], 10, 64)
+		if err != nil {
+			continue
+		}
+		switch {
+		case rev > latest:
+			previousRS, previous = latestRS, latest
+			latestRS, latest = rs, rev
+		case rev < latest && rev > previous:
+			previousRS, previous = rs, rev
+		}
+	}
+	return previousRS, previous
+}
+
+// undoDeploymentRollout restores the pod template of the previous revision of
+// a deployment, like `kubectl rollout undo` does, and returns that revision.
+func undoDeploymentRollout(ctx context.Context, conn *kubernetes.Clientset, namespace, name string) (int64, error) {
+	deployment, err := conn.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return 0, err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return 0, err
+	}
+	list, err := conn.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return 0, err
+	}
+	rs, revision := previousReplicaSet(deployment, list.Items)
+	if rs == nil {
+		return 0, fmt.Errorf("no previous revision to roll back to")
+	}
+
+	template := rs.Spec.Template.DeepCopy()
+	delete(template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+	ops := PatchOperations{&ReplaceOperation{
+		Path:  "/spec/template",
+		Value: template,
+	}}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return 0, err
+	}
+	_, err = conn.AppsV1().Deployments(namespace).Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return 0, err
+	}
+	return revision, nil
+}
+
+// waitForReady waits for the resource name to be ready, as computed by
+// util.ComputeReadiness, and stops early when its status shows it cannot
+// become ready.
+func waitForReady(ctx context.Context, r dynamic.ResourceInterface, name string, timeout time.Duration) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		res, err := r.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		readiness := util.ComputeReadiness(res)
+		switch readiness.Status {
+		case util.ReadinessCurrent:
+			return nil
+		case util.ReadinessFailed:
+			return retry.NonRetryableError(fmt.Errorf("%s %q failed to become ready: %s", res.GetKind(), name, readiness.Message))
+		}
+		return retry.RetryableError(fmt.Errorf("%s %q is not ready: %s", res.GetKind(), name, readiness.Message))
+	})
+}
+
+// waitForObjectReady waits for obj, written by a typed resource, to be ready
+// when the `wait_for_ready` attribute of the resource is set.
+func waitForObjectReady(ctx context.Context, d *schema.ResourceData, meta interface{}, apiVersion, kind string, obj metav1.Object, timeout string) diag.Diagnostics {
+	if !d.Get("wait_for_ready").(bool) {
+		return nil
+	}
+	r, err := dynamicResourceInterface(meta, apiVersion, kind, obj.GetNamespace())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Waiting for %s %q to be ready", kind, obj.GetName())
+	if err := waitForReady(ctx, r, obj.GetName(), d.Timeout(timeout)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/utils/ptr"
+)
+
+func TestRestartPodTemplate(t *testing.T) {
+	live := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{restartedAtAnnotation: "2024-01-01T00:00:00Z"},
+		},
+	}
+
+	cases := map[string]struct {
+		config   map[string]interface{}
+		template corev1.PodTemplateSpec
+		live     corev1.PodTemplateSpec
+		expected func(string) bool
+	}{
+		"carried over": {
+			config:   map[string]interface{}{},
+			live:     live,
+			expected: func(v string) bool { return v == "2024-01-01T00:00:00Z" },
+		},
+		"never restarted": {
+			config:   map[string]interface{}{},
+			expected: func(v string) bool { return v == "" },
+		},
+		"triggered": {
+			config: map[string]interface{}{
+				"restart_triggers": map[string]interface{}{"config": "abc"},
+			},
+			live:     live,
+			expected: func(v string) bool { return v != "" && v != "2024-01-01T00:00:00Z" },
+		},
+		"configured": {
+			config: map[string]interface{}{
+				"restart_triggers": map[string]interface{}{"config": "abc"},
+			},
+			template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{restartedAtAnnotation: "manual"},
+				},
+			},
+			live:     live,
+			expected: func(v string) bool { return v == "manual" },
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceKubernetesDeploymentSchemaV1(), tc.config)
+			restartPodTemplate(d, &tc.template, tc.live)
+			if v := tc.template.Annotations[restartedAtAnnotation]; !tc.expected(v) {
+				t.Fatalf("unexpected restartedAt annotation %q", v)
+			}
+		})
+	}
+}
+
+func TestHideRestartedAt(t *testing.T) {
+	template := func() *corev1.PodTemplateSpec {
+		return &corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					restartedAtAnnotation: "2024-01-01T00:00:00Z",
+					"team":                "x",
+				},
+			},
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceKubernetesDeploymentSchemaV1(), map[string]interface{}{})
+	tpl := template()
+	hideRestartedAt(d, tpl)
+	if _, ok := tpl.Annotations[restartedAtAnnotation]; ok {
+		t.Fatal("expected the restartedAt annotation to be hidden")
+	}
+	if tpl.Annotations["team"] != "x" {
+		t.Fatal("expected other annotations to be kept")
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceKubernetesDeploymentSchemaV1(), map[string]interface{}{
+		"spec": []interface{}{map[string]interface{}{
+			"template": []interface{}{map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{
+					"annotations": map[string]interface{}{restartedAtAnnotation: "2024-01-01T00:00:00Z"},
+				}},
+			}},
+		}},
+	})
+	tpl = template()
+	hideRestartedAt(d, tpl)
+	if _, ok := tpl.Annotations[restartedAtAnnotation]; !ok {
+		t.Fatal("expected the configured restartedAt annotation to be kept")
+	}
+}
+
+func TestPreviousReplicaSet(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", UID: "deployment-uid"},
+	}
+	replicaSet := func(name, revision string, uid string) appsv1.ReplicaSet {
+		return appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Annotations: map[string]string{deploymentRevisionAnnotation: revision},
+				OwnerReferences: []metav1.OwnerReference{{
+					Name:       "app",
+					UID:        types.UID(uid),
+					Controller: ptr.To(true),
+				}},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		replicaSets      []appsv1.ReplicaSet
+		expectedName     string
+		expectedRevision int64
+	}{
+		"previous": {
+			replicaSets: []appsv1.ReplicaSet{
+				replicaSet("app-1", "1", "deployment-uid"),
+				replicaSet("app-3", "3", "deployment-uid"),
+				replicaSet("app-2", "2", "deployment-uid"),
+			},
+			expectedName:     "app-2",
+			expectedRevision: 2,
+		},
+		"other owner": {
+			replicaSets: []appsv1.ReplicaSet{
+				replicaSet("app-1", "1", "deployment-uid"),
+				replicaSet("other-4", "4", "other-uid"),
+				replicaSet("app-2", "2", "deployment-uid"),
+			},
+			expectedName:     "app-1",
+			expectedRevision: 1,
+		},
+		"single revision": {
+			replicaSets: []appsv1.ReplicaSet{
+				replicaSet("app-1", "1", "deployment-uid"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rs, revision := previousReplicaSet(deployment, tc.replicaSets)
+			if tc.expectedName == "" {
+				if rs != nil {
+					t.Fatalf("expected no previous ReplicaSet, got %q", rs.Name)
+				}
+				return
+			}
+			if rs == nil || rs.Name != tc.expectedName || revision != tc.expectedRevision {
+				t.Fatalf("expected %q at revision %d, got %v at revision %d", tc.expectedName, tc.expectedRevision, rs, revision)
+			}
+		})
+	}
+}
+
+func TestWaitForReady(t *testing.T) {
+	gvr := k8sschema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}
+	job := func(name, condition string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "batch/v1",
+			"kind":       "Job",
+			"metadata":   map[string]interface{}{"name": name, "namespace": "default"},
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": condition, "status": "True", "message": "BackoffLimitExceeded"},
+				},
+			},
+		}}
+	}
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[k8sschema.GroupVersionResource]string{gvr: "JobList"},
+		job("complete", "Complete"), job("failed", "Failed"))
+	r := client.Resource(gvr).Namespace("default")
+
+	if err := waitForReady(context.Background(), r, "complete", time.Minute); err != nil {
+		t.Errorf("expected the complete job to be ready, got %q", err)
+	}
+	// a failed job cannot become ready, so there is no waiting for the timeout
+	start := time.Now()
+	err := waitForReady(context.Background(), r, "failed", time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "BackoffLimitExceeded") {
+		t.Errorf("expected the failed job to fail, got %v", err)
+	}
+	if time.Since(start) > 10*time.Second {
+		t.Error("expected a failed job to stop the wait")
+	}
+}
@@ -12,7 +12,9 @@ import (
 
 func horizontalPodAutoscalerSchemaV2() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
-		"metadata": namespacedMetadataSchema("horizontal pod autoscaler", true),
+		"metadata":         namespacedMetadataSchema("horizontal pod autoscaler", true),
+		"delete_options":   deleteOptionsSchema(),
+		"destroy_behavior": destroyBehaviorSchema(),
 		"spec": {
 			Type:        schema.TypeList,
 			Description: "Behaviour of the autoscaler. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status",
@@ -92,8 +92,9 @@ func namespacedMetadataSchemaIsTemplate(objectName string, generatableName, isTe
 		Type:        schema.TypeString,
 		Description: fmt.Sprintf("Namespace defines the space within which name of the %s must be unique.", objectName),
 		Optional:    true,
-		ForceNew:    true,
-		Default:     conditionalDefault(!isTemplate, "default"),
+		// the provider namespace is used when it is not set, see expandMetadataWithNamespace
+		Computed: !isTemplate,
+		ForceNew: true,
 	}
 	if generatableName {
 		fields["generate_name"] = &schema.Schema{
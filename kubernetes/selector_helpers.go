@@ -0,0 +1,314 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+)
+
+// resourceSelectorSchema returns the schema of the `selector` block, used in
+// place of `metadata` to target every object matching a label or field selector.
+func resourceSelectorSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Description:  "Select the resources to target by label or field, instead of by name. Resources that start matching the selector are updated on the next apply.",
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"metadata", "selector"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"label_selector": {
+					Type:        schema.TypeString,
+					Description: "A label selector, e.g. `team=x`.",
+					Optional:    true,
+					ForceNew:    true,
+				},
+				"field_selector": {
+					Type:        schema.TypeString,
+					Description: "A field selector, e.g. `metadata.name!=default`.",
+					Optional:    true,
+					ForceNew:    true,
+				},
+				"namespace": {
+					Type:        schema.TypeString,
+					Description: "The namespace of the resources to select. Resources in all namespaces are selected when it is not set.",
+					Optional:    true,
+					ForceNew:    true,
+				},
+			},
+		},
+	}
+}
+
+// selectorTargetsSchema returns the schema of the computed `targets` attribute,
+// which records the objects updated through the `selector` block.
+func selectorTargetsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The objects matching `selector` when the resource was last applied, as `namespace/name`, or `name` for cluster-scoped objects. The objects that stop matching the selector are released on the next apply, and all of them when the resource is destroyed.",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+type resourceSelector struct {
+	LabelSelector string
+	FieldSelector string
+	Namespace     string
+}
+
+func expandResourceSelector(in []interface{}) resourceSelector {
+	s := resourceSelector{}
+	if len(in) == 0 || in[0] == nil {
+		return s
+	}
+	m := in[0].(map[string]interface{})
+	s.LabelSelector, _ = m["label_selector"].(string)
+	s.FieldSelector, _ = m["field_selector"].(string)
+	s.Namespace, _ = m["namespace"].(string)
+	return s
+}
+
+// buildSelectorId returns the ID of a resource targeting the objects matching s.
+// Selectors can contain the separators of the ID, so only their hash is used.
+func buildSelectorId(apiVersion, kind string, s resourceSelector) string {
+	h := sha256.Sum256([]byte(s.LabelSelector + "\n" + s.FieldSelector + "\n" + s.Namespace))
+	return fmt.Sprintf("apiVersion=%v,kind=%v,selector=%x", apiVersion, kind, h[:8])
+}
+
+// selectedObjects returns the objects matching the selector of d, along with
+// the client of their resource.
+func selectedObjects(ctx context.Context, d *schema.ResourceData, m interface{}) (dynamic.NamespaceableResourceInterface, []unstructured.Unstructured, error) {
+	conn, err := m.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	dc, err := m.(KubeClientsets).DiscoveryClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	agr, err := restmapper.GetAPIGroupResources(dc)
+	if err != nil {
+		return nil, nil, err
+	}
+	gv, err := k8sschema.ParseGroupVersion(d.Get("api_version").(string))
+	if err != nil {
+		return nil, nil, err
+	}
+	mapping, err := restmapper.NewDiscoveryRESTMapper(agr).RESTMapping(gv.WithKind(d.Get("kind").(string)).GroupKind(), gv.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := conn.Resource(mapping.Resource)
+
+	s := expandResourceSelector(d.Get("selector").([]interface{}))
+	var ri dynamic.ResourceInterface = r
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace && s.Namespace != "" {
+		ri = r.Namespace(s.Namespace)
+	}
+	list, err := ri.List(ctx, v1.ListOptions{
+		LabelSelector: s.LabelSelector,
+		FieldSelector: s.FieldSelector,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return r, list.Items, nil
+}
+
+// updateSelectedObjects calls apply for each object matching the selector of d,
+// and saves them to the targets of d. The objects recorded in the targets that
+// no longer match the selector are released, as are all the objects when d is
+// being deleted, by calling apply with release set.
+// The objects are checked against the protection settings of the provider,
+// as their names are not known in advance: when protection denies any of
+// them, all denials are returned and no object is updated.
+func updateSelectedObjects(ctx context.Context, d *schema.ResourceData, m interface{}, apply func(r dynamic.ResourceInterface, obj unstructured.Unstructured, release bool) diag.Diagnostics) diag.Diagnostics {
+	r, objs, err := selectedObjects(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	dropped, err := droppedTargets(ctx, d, r, objs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	op := util.OperationUpdate
+	if d.Id() == "" {
+		op = util.OperationDelete
+	}
+	diags := checkSelectedObjects(protectionConfig(m), op, append(append([]unstructured.Unstructured{}, objs...), dropped...))
+	if diags.HasError() {
+		return diags
+	}
+
+	targets := map[string]bool{}
+	for _, t := range d.Get("targets").([]interface{}) {
+		targets[t.(string)] = true
+	}
+	// the targets are saved even if an object fails, so that the objects
+	// updated so far are released later on
+	defer func() {
+		if d.Id() != "" {
+			d.Set("targets", sortedKeys(targets))
+		}
+	}()
+	release := d.Id() == ""
+	for _, obj := range objs {
+		targets[selectorTarget(obj)] = true
+		diags = append(diags, apply(objectResourceInterface(r, obj), obj, release)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	for _, obj := range dropped {
+		diags = append(diags, apply(objectResourceInterface(r, obj), obj, true)...)
+		if diags.HasError() {
+			return diags
+		}
+		delete(targets, selectorTarget(obj))
+	}
+	return diags
+}
+
+// droppedTargets returns the objects recorded in the targets of d that still
+// exist but no longer match its selector, given the objects matching it.
+func droppedTargets(ctx context.Context, d *schema.ResourceData, r dynamic.NamespaceableResourceInterface, objs []unstructured.Unstructured) ([]unstructured.Unstructured, error) {
+	var dropped []unstructured.Unstructured
+	for _, target := range missingTargets(d, objs) {
+		var ri dynamic.ResourceInterface = r
+		name := target
+		if namespace, n, ok := strings.Cut(target, "/"); ok {
+			ri, name = r.Namespace(namespace), n
+		}
+		obj, err := ri.Get(ctx, name, v1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		dropped = append(dropped, *obj)
+	}
+	return dropped, nil
+}
+
+// missingTargets returns the targets of d that are not in objs.
+func missingTargets(d *schema.ResourceData, objs []unstructured.Unstructured) []string {
+	selected := make(map[string]bool, len(objs))
+	for _, obj := range objs {
+		selected[selectorTarget(obj)] = true
+	}
+	var missing []string
+	for _, t := range d.Get("targets").([]interface{}) {
+		if target := t.(string); !selected[target] {
+			missing = append(missing, target)
+		}
+	}
+	return missing
+}
+
+func selectorTarget(obj unstructured.Unstructured) string {
+	if ns := obj.GetNamespace(); ns != "" {
+		return ns + "/" + obj.GetName()
+	}
+	return obj.GetName()
+}
+
+func objectResourceInterface(r dynamic.NamespaceableResourceInterface, obj unstructured.Unstructured) dynamic.ResourceInterface {
+	if ns := obj.GetNamespace(); ns != "" {
+		return r.Namespace(ns)
+	}
+	return r
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// checkSelectedObjects checks all objs against the protection settings p, and
+// returns a diagnostic for each protected object.
+func checkSelectedObjects(p *util.Protection, op string, objs []unstructured.Unstructured) diag.Diagnostics {
+	severity := diag.Error
+	if p.Warn() {
+		severity = diag.Warning
+	}
+	var diags diag.Diagnostics
+	for _, obj := range objs {
+		if err := p.Check(op, obj.GetKind(), obj.GetNamespace(), obj.GetName()); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: severity,
+				Summary:  "Protected object",
+				Detail:   err.Error(),
+			})
+		}
+	}
+	return diags
+}
+
+// selectedStateValues returns the map of a resource targeting several objects
+// to store in state: values lists the current map of each object and managed
+// its keys owned by the field manager. A configured key is only kept when all
+// objects have the configured value, so that objects that start matching the
+// selector show as a change. Other keys are kept while Terraform still
+// manages them on any of the objects.
+func selectedStateValues(configured map[string]interface{}, values []map[string]string, managed []map[string]interface{}) map[string]interface{} {
+	out := map[string]interface{}{}
+	for k, v := range configured {
+		all := true
+		for _, vals := range values {
+			if cv, ok := vals[k]; !ok || cv != v {
+				all = false
+				break
+			}
+		}
+		if all {
+			out[k] = v
+		}
+	}
+	for i, vals := range values {
+		for k, v := range vals {
+			if _, ok := configured[k]; ok {
+				continue
+			}
+			if _, ok := managed[i]["f:"+k]; ok {
+				out[k] = v
+			}
+		}
+	}
+	return out
+}
+
+// withoutConfiguredKeys returns values without the keys of configured, so that
+// the plan shows a change and the next apply releases the objects that no
+// longer match the selector.
+func withoutConfiguredKeys(values, configured map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		if _, ok := configured[k]; !ok {
+			out[k] = v
+		}
+	}
+	return out
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func TestSelectedStateValues(t *testing.T) {
+	configured := map[string]interface{}{
+		"team":  "x",
+		"owner": "y",
+	}
+	values := []map[string]string{
+		{"team": "x", "owner": "y", "removed": "z", "other": "o"},
+		// this object started matching the selector after the last apply
+		{"owner": "y"},
+	}
+	managed := []map[string]interface{}{
+		{"f:team": map[string]interface{}{}, "f:owner": map[string]interface{}{}, "f:removed": map[string]interface{}{}},
+		{},
+	}
+	expected := map[string]interface{}{
+		"owner":   "y",
+		"removed": "z",
+	}
+	if got := selectedStateValues(configured, values, managed); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestBuildSelectorId(t *testing.T) {
+	a := buildSelectorId("v1", "Namespace", resourceSelector{LabelSelector: "team=x"})
+	b := buildSelectorId("v1", "Namespace", resourceSelector{LabelSelector: "team=y"})
+	if a == b {
+		t.Fatalf("expected different selectors to have different IDs, got %q", a)
+	}
+	if a != buildSelectorId("v1", "Namespace", resourceSelector{LabelSelector: "team=x"}) {
+		t.Fatal("expected the ID to be stable")
+	}
+}
+
+func TestCheckSelectedObjects(t *testing.T) {
+	object := func(namespace, name string) unstructured.Unstructured {
+		obj := unstructured.Unstructured{}
+		obj.SetKind("ConfigMap")
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		return obj
+	}
+	objs := []unstructured.Unstructured{
+		object("apps", "a"),
+		object("kube-system", "b"),
+		object("kube-public", "c"),
+	}
+
+	deny, err := util.NewProtection([]string{"kube-*"}, nil, util.ProtectionModeDeny)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diags := checkSelectedObjects(deny, util.OperationUpdate, objs)
+	if len(diags) != 2 || !diags.HasError() {
+		t.Fatalf("expected both protected objects to be denied, got %v", diags)
+	}
+
+	warn, err := util.NewProtection([]string{"kube-*"}, nil, util.ProtectionModeWarn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diags = checkSelectedObjects(warn, util.OperationUpdate, objs)
+	if len(diags) != 2 || diags.HasError() || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected warnings for both protected objects, got %v", diags)
+	}
+
+	if diags := checkSelectedObjects(nil, util.OperationUpdate, objs); len(diags) != 0 {
+		t.Fatalf("expected no diagnostics without protection, got %v", diags)
+	}
+}
+
+func TestDroppedTargets(t *testing.T) {
+	object := func(namespace, name string) unstructured.Unstructured {
+		obj := unstructured.Unstructured{}
+		obj.SetAPIVersion("v1")
+		obj.SetKind("ConfigMap")
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		return obj
+	}
+	a, b := object("apps", "a"), object("apps", "b")
+	gvr := k8sschema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	r := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), &a, &b).Resource(gvr)
+
+	d := schema.TestResourceDataRaw(t, resourceKubernetesLabels().Schema, map[string]interface{}{})
+	d.Set("targets", []string{"apps/a", "apps/b", "apps/deleted"})
+
+	// b no longer matches the selector, and the deleted object is skipped
+	dropped, err := droppedTargets(context.Background(), d, r, []unstructured.Unstructured{a})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dropped) != 1 || selectorTarget(dropped[0]) != "apps/b" {
+		t.Fatalf("expected apps/b to be dropped, got %v", dropped)
+	}
+	if missing := missingTargets(d, []unstructured.Unstructured{a, b}); !reflect.DeepEqual(missing, []string{"apps/deleted"}) {
+		t.Fatalf("expected apps/deleted to be missing, got %v", missing)
+	}
+}
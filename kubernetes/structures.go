@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -68,21 +69,103 @@ func expandMetadata(in []interface{}) metav1.ObjectMeta {
 	return meta
 }
 
-func patchMetadata(keyPrefix, pathPrefix string, d *schema.ResourceData) PatchOperations {
+// expandMetadataWithNamespace expands namespaced metadata, using the provider
+// namespace when the metadata does not set one.
+func expandMetadataWithNamespace(in []interface{}, providerMeta interface{}) metav1.ObjectMeta {
+	meta := expandMetadata(in)
+	if len(in) == 0 || in[0] == nil {
+		return meta
+	}
+	if _, ok := in[0].(map[string]interface{})["namespace"]; ok && meta.Namespace == "" {
+		meta.Namespace = defaultNamespace(providerMeta)
+	}
+	return meta
+}
+
+// defaultNamespace returns the namespace used by namespaced objects that do not set one.
+func defaultNamespace(providerMeta interface{}) string {
+	if pm, ok := providerMeta.(providerMetadata); ok && pm.Namespace != "" {
+		return pm.Namespace
+	}
+	return util.DefaultNamespace
+}
+
+// expandMetadataWithDefaults expands the metadata of a resource and adds the
+// provider default namespace, labels and annotations that the resource does not set.
+func expandMetadataWithDefaults(in []interface{}, providerMeta interface{}) metav1.ObjectMeta {
+	meta := expandMetadataWithNamespace(in, providerMeta)
+	if pm, ok := providerMeta.(providerMetadata); ok {
+		meta.Annotations = mergeDefaultKeys(pm.DefaultAnnotations, meta.Annotations)
+		meta.Labels = mergeDefaultKeys(pm.DefaultLabels, meta.Labels)
+	}
+	return meta
+}
+
+func mergeDefaultKeys(defaults, m map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return m
+	}
+	result := make(map[string]string, len(defaults)+len(m))
+	for k, v := range defaults {
+		result[k] = v
+	}
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
+func patchMetadata(keyPrefix, pathPrefix string, d *schema.ResourceData, providerMeta interface{}) PatchOperations {
+	var defaultAnnotations, defaultLabels map[string]string
+	if pm, ok := providerMeta.(providerMetadata); ok {
+		defaultAnnotations = pm.DefaultAnnotations
+		defaultLabels = pm.DefaultLabels
+	}
+
 	ops := make([]PatchOperation, 0)
+	if hasAttribute(d, "labels_all") {
+		// the computed attributes hold the keys on the object, provider defaults included
+		for _, k := range []struct {
+			key      string
+			defaults map[string]string
+		}{{"annotations", defaultAnnotations}, {"labels", defaultLabels}} {
+			if d.HasChanges(keyPrefix+k.key, k.key+"_all") {
+				oldV, _ := d.GetChange(k.key + "_all")
+				newV := withDefaultKeys(k.defaults, d.Get(keyPrefix+k.key).(map[string]interface{}))
+				ops = append(ops, diffStringMap(pathPrefix+k.key, oldV.(map[string]interface{}), newV)...)
+			}
+		}
+		return ops
+	}
 	if d.HasChange(keyPrefix + "annotations") {
 		oldV, newV := d.GetChange(keyPrefix + "annotations")
-		diffOps := diffStringMap(pathPrefix+"annotations", oldV.(map[string]interface{}), newV.(map[string]interface{}))
+		diffOps := diffStringMap(pathPrefix+"annotations", oldV.(map[string]interface{}), withDefaultKeys(defaultAnnotations, newV.(map[string]interface{})))
 		ops = append(ops, diffOps...)
 	}
 	if d.HasChange(keyPrefix + "labels") {
 		oldV, newV := d.GetChange(keyPrefix + "labels")
-		diffOps := diffStringMap(pathPrefix+"labels", oldV.(map[string]interface{}), newV.(map[string]interface{}))
+		diffOps := diffStringMap(pathPrefix+"labels", oldV.(map[string]interface{}), withDefaultKeys(defaultLabels, newV.(map[string]interface{})))
 		ops = append(ops, diffOps...)
 	}
 	return ops
 }
 
+// withDefaultKeys adds the default keys that are missing from m, which is
+// left untouched.
+func withDefaultKeys(defaults map[string]string, m map[string]interface{}) map[string]interface{} {
+	if len(defaults) == 0 {
+		return m
+	}
+	result := make(map[string]interface{}, len(defaults)+len(m))
+	for k, v := range defaults {
+		result[k] = v
+	}
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
 func expandStringMap(m map[string]interface{}) map[string]string {
 	result := make(map[string]string)
 	for k, v := range m {
@@ -134,6 +217,9 @@ func flattenMetadataFields(meta metav1.ObjectMeta) []interface{} {
 	return []interface{}{m}
 }
 
+// flattenMetadata flattens the metadata of a resource without the internal, ignored and
+// default keys it does not set. The keys of the object, defaults included, are saved to
+// the labels_all and annotations_all attributes of the resources that have them.
 func flattenMetadata(meta metav1.ObjectMeta, d *schema.ResourceData, providerMeta interface{}) []interface{} {
 	metadataAnnotations := d.Get("metadata.0.annotations").(map[string]interface{})
 	metadataLabels := d.Get("metadata.0.labels").(map[string]interface{})
@@ -141,10 +227,14 @@ func flattenMetadata(meta metav1.ObjectMeta, d *schema.ResourceData, providerMet
 	ignoreAnnotations := providerMeta.(providerMetadata).IgnoreAnnotations
 	removeInternalKeys(meta.Annotations, metadataAnnotations)
 	removeKeys(meta.Annotations, metadataAnnotations, ignoreAnnotations)
+	setDefaultMetadata(d, "annotations", meta.Annotations)
+	removeDefaultKeys(meta.Annotations, metadataAnnotations, providerMeta.(providerMetadata).DefaultAnnotations)
 
 	ignoreLabels := providerMeta.(providerMetadata).IgnoreLabels
 	removeInternalKeys(meta.Labels, metadataLabels)
 	removeKeys(meta.Labels, metadataLabels, ignoreLabels)
+	setDefaultMetadata(d, "labels", meta.Labels)
+	removeDefaultKeys(meta.Labels, metadataLabels, providerMeta.(providerMetadata).DefaultLabels)
 
 	return flattenMetadataFields(meta)
 }
@@ -167,6 +257,15 @@ func removeKeys(m map[string]string, d map[string]interface{}, ignoreKubernetesM
 	}
 }
 
+// removeDefaultKeys removes the keys that hold the provider default value, unless they are set on the resource.
+func removeDefaultKeys(m map[string]string, d map[string]interface{}, defaults map[string]string) {
+	for k, v := range m {
+		if dv, ok := defaults[k]; ok && dv == v && !isKeyInMap(k, d) {
+			delete(m, k)
+		}
+	}
+}
+
 func isKeyInMap(key string, d map[string]interface{}) bool {
 	_, ok := d[key]
 	return ok
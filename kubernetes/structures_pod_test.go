@@ -702,7 +702,7 @@ func TestFlattenCSIVolumeSource(t *testing.T) {
 func TestExpandWindowsOptions(t *testing.T) {
 	cases := []struct {
 		Input          []interface{}
-		ExpectedOutput *v1.WindowsSecurityContextOptions
+		ExpectedOutput *corev1.WindowsSecurityContextOptions
 	}{
 		{
 			[]interface{}{
@@ -713,7 +713,7 @@ func TestExpandWindowsOptions(t *testing.T) {
 					"run_as_username":           "DOMAIN\\serviceaccount",
 				},
 			},
-			&v1.WindowsSecurityContextOptions{
+			&corev1.WindowsSecurityContextOptions{
 				GMSACredentialSpec:     ptr.To(`{"CmsPlugins":["ActiveDirectory"],"DomainJoinConfig":{"Sid":"S-1-5-21-1234567890-1234567890-1234567890","MachineAccountName":"webapp01","Guid":"12345678-1234-1234-1234-123456789012","DnsTreeName":"contoso.com","DnsName":"contoso.com","NetBiosName":"CONTOSO"},"ActiveDirectoryConfig":{"GroupManagedServiceAccounts":[{"Name":"webapp01","Scope":"contoso.com"}]}}`),
 				HostProcess:            ptr.To(true),
 				GMSACredentialSpecName: ptr.To("credspecname1"),
@@ -727,7 +727,7 @@ func TestExpandWindowsOptions(t *testing.T) {
 					"host_process":         false,
 				},
 			},
-			&v1.WindowsSecurityContextOptions{
+			&corev1.WindowsSecurityContextOptions{
 				GMSACredentialSpec: ptr.To(`{"CmsPlugins":["ActiveDirectory"],"DomainJoinConfig":{"Sid":"S-1-5-21-9876543210-9876543210-9876543210","MachineAccountName":"webapi01","Guid":"87654321-4321-4321-4321-210987654321","DnsTreeName":"corp.local","DnsName":"corp.local","NetBiosName":"CORP"},"ActiveDirectoryConfig":{"GroupManagedServiceAccounts":[{"Name":"webapi01","Scope":"corp.local"}]}}`),
 				HostProcess:        ptr.To(false),
 			},
@@ -739,7 +739,7 @@ func TestExpandWindowsOptions(t *testing.T) {
 					"run_as_username":           "NT AUTHORITY\\SYSTEM",
 				},
 			},
-			&v1.WindowsSecurityContextOptions{
+			&corev1.WindowsSecurityContextOptions{
 				GMSACredentialSpecName: ptr.To("credspecname2"),
 				RunAsUserName:          ptr.To("NT AUTHORITY\\SYSTEM"),
 			},
@@ -752,15 +752,15 @@ func TestExpandWindowsOptions(t *testing.T) {
 					"run_as_username":           "",
 				},
 			},
-			&v1.WindowsSecurityContextOptions{},
+			&corev1.WindowsSecurityContextOptions{},
 		},
 		{
 			[]interface{}{},
-			&v1.WindowsSecurityContextOptions{},
+			&corev1.WindowsSecurityContextOptions{},
 		},
 		{
 			nil,
-			&v1.WindowsSecurityContextOptions{},
+			&corev1.WindowsSecurityContextOptions{},
 		},
 	}
 
@@ -775,11 +775,11 @@ func TestExpandWindowsOptions(t *testing.T) {
 
 func TestFlattenWindowsOptions(t *testing.T) {
 	cases := []struct {
-		Input          v1.WindowsSecurityContextOptions
+		Input          corev1.WindowsSecurityContextOptions
 		ExpectedOutput []interface{}
 	}{
 		{
-			v1.WindowsSecurityContextOptions{
+			corev1.WindowsSecurityContextOptions{
 				GMSACredentialSpec:     ptr.To(`{"CmsPlugins":["ActiveDirectory"],"DomainJoinConfig":{"Sid":"S-1-5-21-1234567890-1234567890-1234567890","MachineAccountName":"webapp01","Guid":"12345678-1234-1234-1234-123456789012","DnsTreeName":"contoso.com","DnsName":"contoso.com","NetBiosName":"CONTOSO"},"ActiveDirectoryConfig":{"GroupManagedServiceAccounts":[{"Name":"webapp01","Scope":"contoso.com"}]}}`),
 				HostProcess:            ptr.To(true),
 				GMSACredentialSpecName: ptr.To("credspecname1"),
@@ -795,7 +795,7 @@ func TestFlattenWindowsOptions(t *testing.T) {
 			},
 		},
 		{
-			v1.WindowsSecurityContextOptions{
+			corev1.WindowsSecurityContextOptions{
 				GMSACredentialSpec: ptr.To(`{"CmsPlugins":["ActiveDirectory"],"DomainJoinConfig":{"Sid":"S-1-5-21-9876543210-9876543210-9876543210","MachineAccountName":"webapi01","Guid":"87654321-4321-4321-4321-210987654321","DnsTreeName":"corp.local","DnsName":"corp.local","NetBiosName":"CORP"},"ActiveDirectoryConfig":{"GroupManagedServiceAccounts":[{"Name":"webapi01","Scope":"corp.local"}]}}`),
 				HostProcess:        ptr.To(false),
 			},
@@ -807,7 +807,7 @@ func TestFlattenWindowsOptions(t *testing.T) {
 			},
 		},
 		{
-			v1.WindowsSecurityContextOptions{},
+			corev1.WindowsSecurityContextOptions{},
 			[]interface{}{
 				map[string]interface{}{},
 			},
@@ -821,4 +821,4 @@ func TestFlattenWindowsOptions(t *testing.T) {
 				tc.ExpectedOutput, output)
 		}
 	}
-}
\ No newline at end of file
+}
@@ -242,7 +242,7 @@ func flattenStatefulSetSpecPersistentVolumeClaimRetentionPolicy(s v1.StatefulSet
 
 // Patchers
 
-func patchStatefulSetSpec(d *schema.ResourceData) (PatchOperations, error) {
+func patchStatefulSetSpec(d *schema.ResourceData, live corev1.PodTemplateSpec) (PatchOperations, error) {
 	ops := PatchOperations{}
 
 	if d.HasChange("spec.0.replicas") {
@@ -259,12 +259,13 @@ func patchStatefulSetSpec(d *schema.ResourceData) (PatchOperations, error) {
 		}
 	}
 
-	if d.HasChange("spec.0.template") {
+	if d.HasChange("spec.0.template") || d.HasChange("restart_triggers") {
 		log.Printf("[TRACE] StatefulSet.Spec.Template has changes")
 		template, err := expandPodTemplate(d.Get("spec.0.template").([]interface{}))
 		if err != nil {
 			return ops, err
 		}
+		restartPodTemplate(d, template, live)
 		ops = append(ops, &ReplaceOperation{
 			Path:  "/spec/template",
 			Value: template,
@@ -269,6 +269,40 @@ func TestFlattenMetadata(t *testing.T) {
 				"uid":              uid,
 			}},
 		},
+		"DefaultAnnotationsAndLabels": {
+			metav1.ObjectMeta{
+				Annotations: map[string]string{
+					"owner.example.com": "platform",
+					"bar.example.com":   "foo",
+				},
+				GenerateName: "",
+				Generation:   1,
+				Labels: map[string]string{
+					"team": "platform",
+					"env":  "dev",
+				},
+				Name:            "foo",
+				Namespace:       "",
+				ResourceVersion: "1",
+				UID:             types.UID(uid),
+			},
+			providerMetadata{
+				DefaultAnnotations: map[string]string{"owner.example.com": "platform"},
+				DefaultLabels:      map[string]string{"team": "platform", "env": "prod"},
+			},
+			[]interface{}{map[string]interface{}{
+				"annotations": map[string]string{
+					"bar.example.com": "foo",
+				},
+				"generation": int64(1),
+				"labels": map[string]string{
+					"env": "dev",
+				},
+				"name":             "foo",
+				"resource_version": "1",
+				"uid":              uid,
+			}},
+		},
 	}
 	rawData := map[string]interface{}{
 		"metadata": []interface{}{map[string]interface{}{
@@ -290,3 +324,51 @@ func TestFlattenMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestExpandMetadataWithDefaults(t *testing.T) {
+	in := []interface{}{map[string]interface{}{
+		"name": "foo",
+		"labels": map[string]interface{}{
+			"team": "payments",
+		},
+	}}
+	providerMeta := providerMetadata{
+		DefaultAnnotations: map[string]string{"owner.example.com": "platform"},
+		DefaultLabels:      map[string]string{"team": "platform", "cost-center": "42"},
+	}
+	expected := metav1.ObjectMeta{
+		Name:        "foo",
+		Annotations: map[string]string{"owner.example.com": "platform"},
+		Labels: map[string]string{
+			"team":        "payments",
+			"cost-center": "42",
+		},
+	}
+	out := expandMetadataWithDefaults(in, providerMeta)
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf("Error matching output and expected: %#v vs %#v", out, expected)
+	}
+}
+
+func TestExpandMetadataWithNamespace(t *testing.T) {
+	providerMeta := providerMetadata{Namespace: "team-a"}
+	cases := []struct {
+		in       []interface{}
+		expected string
+	}{
+		{[]interface{}{map[string]interface{}{"name": "foo", "namespace": ""}}, "team-a"},
+		{[]interface{}{map[string]interface{}{"name": "foo", "namespace": "bar"}}, "bar"},
+		// cluster-scoped metadata has no namespace attribute
+		{[]interface{}{map[string]interface{}{"name": "foo"}}, ""},
+	}
+	for i, tc := range cases {
+		out := expandMetadataWithNamespace(tc.in, providerMeta)
+		if out.Namespace != tc.expected {
+			t.Errorf("case %d: expected namespace %q got %q", i, tc.expected, out.Namespace)
+		}
+	}
+	out := expandMetadataWithNamespace([]interface{}{map[string]interface{}{"namespace": ""}}, nil)
+	if out.Namespace != "default" {
+		t.Errorf("expected namespace %q without provider configuration, got %q", "default", out.Namespace)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/robfig/cron"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -225,6 +226,19 @@ func validateTypeStringNullableInt(v interface{}, k string) (ws []string, es []e
 	return
 }
 
+func validateTypeStringNullableNonNegativeInt(v interface{}, k string) (ws []string, es []error) {
+	ws, es = validateTypeStringNullableInt(v, k)
+	if len(es) > 0 {
+		return
+	}
+	if value := v.(string); value != "" {
+		if i, _ := strconv.ParseInt(value, 10, 64); i < 0 {
+			es = append(es, fmt.Errorf("%s: '%s' must not be negative", k, value))
+		}
+	}
+	return
+}
+
 func validateModeBits(value interface{}, key string) (ws []string, es []error) {
 	if !strings.HasPrefix(value.(string), "0") {
 		es = append(es, fmt.Errorf("%s: value %s should start with '0' (octal numeral)", key, value.(string)))
@@ -301,3 +315,16 @@ func validateCronExpression(v interface{}, k string) ([]string, []error) {
 
 	return []string{}, errors
 }
+
+func validateDuration(v interface{}, k string) ([]string, []error) {
+	errors := make([]error, 0)
+
+	d, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q should be a valid duration, e.g. \"30s\" or \"5m\": %s", k, err))
+	} else if d < 0 {
+		errors = append(errors, fmt.Errorf("%q must not be negative", k))
+	}
+
+	return []string{}, errors
+}
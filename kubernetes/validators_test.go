@@ -164,3 +164,51 @@ func TestValidateTypeStringNullableIntOrPercent(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateTypeStringNullableNonNegativeInt(t *testing.T) {
+	validCases := []string{
+		"",
+		"0",
+		"30",
+	}
+	for _, data := range validCases {
+		_, es := validateTypeStringNullableNonNegativeInt(data, "grace_period_seconds")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", data, es)
+		}
+	}
+	invalidCases := []string{
+		" ",
+		"-1",
+		"1.5",
+		"test",
+	}
+	for _, data := range invalidCases {
+		_, es := validateTypeStringNullableNonNegativeInt(data, "grace_period_seconds")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", data)
+		}
+	}
+}
+
+func TestValidateDuration(t *testing.T) {
+	validCases := []string{
+		"0s", "30s", "5m", "1h30m",
+	}
+	for _, v := range validCases {
+		_, es := validateDuration(v, "startup_timeout")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", v, es)
+		}
+	}
+
+	invalidCases := []string{
+		"", "5", "five minutes", "-1m",
+	}
+	for _, v := range invalidCases {
+		_, es := validateDuration(v, "startup_timeout")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", v)
+		}
+	}
+}
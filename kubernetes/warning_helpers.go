@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
+)
+
+type contextFunc interface {
+	~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+}
+
+// withAPIWarnings wraps the CRUD functions of a resource (or data source) so that
+// the warnings returned by the API server while they run, such as API deprecations
+// or Pod Security Admission violations, are surfaced as warning diagnostics.
+func withAPIWarnings(r *schema.Resource) *schema.Resource {
+	r.CreateContext = wrapWithAPIWarnings(r.CreateContext)
+	r.ReadContext = wrapWithAPIWarnings(r.ReadContext)
+	r.UpdateContext = wrapWithAPIWarnings(r.UpdateContext)
+	r.DeleteContext = wrapWithAPIWarnings(r.DeleteContext)
+	return r
+}
+
+func wrapWithAPIWarnings[F contextFunc](fn F) F {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		ctx, wc := util.ContextWithWarningCollector(ctx)
+		diags := fn(ctx, d, meta)
+		for _, w := range wc.Warnings() {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Kubernetes API server warning",
+				Detail:   w,
+			})
+		}
+		return diags
+	}
+}
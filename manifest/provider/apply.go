@@ -7,12 +7,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest/morph"
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest/payload"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -30,6 +33,9 @@ var (
 func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
 	resp := &tfprotov5.ApplyResourceChangeResponse{}
 
+	ctx, wc := util.ContextWithWarningCollector(ctx)
+	defer func() { resp.Diagnostics = append(resp.Diagnostics, APIWarningsToDiagnostics(wc)...) }()
+
 	execDiag := s.canExecute()
 	if len(execDiag) > 0 {
 		resp.Diagnostics = append(resp.Diagnostics, execDiag...)
@@ -134,6 +140,25 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 		computedFields[atp.String()] = atp
 	}
 
+	// refuse operations on protected objects, or any operation when the
+	// provider is read-only, before any request is made
+	switch {
+	case applyPriorState.IsNull():
+		resp.Diagnostics = append(resp.Diagnostics, s.checkProtection(util.OperationCreate, plannedStateVal["object"])...)
+	case applyPlannedState.IsNull():
+		resp.Diagnostics = append(resp.Diagnostics, s.checkProtection(util.OperationDelete, priorObjectValue(applyPriorState))...)
+	default:
+		resp.Diagnostics = append(resp.Diagnostics, s.checkProtection(util.OperationUpdate, plannedStateVal["object"])...)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			if !applyPriorState.IsNull() {
+				resp.NewState = req.PriorState
+			}
+			return resp, nil
+		}
+	}
+
 	c, err := s.getDynamicClient()
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics,
@@ -183,6 +208,23 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 			return resp, fmt.Errorf("failed to determine resource type ID: %s", err)
 		}
 
+		// Sensitive values are only stored as placeholders in "object". Put back
+		// the configured values from "manifest" before building the payload.
+		sensitivePaths, d := sensitiveFields(plannedStateVal)
+		resp.Diagnostics = append(resp.Diagnostics, d...)
+		if len(d) > 0 {
+			return resp, nil
+		}
+		obj, err = unmaskSensitiveFields(obj, plannedStateVal["manifest"], sensitivePaths)
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Failed to restore sensitive values from manifest",
+				Detail:   err.Error(),
+			})
+			return resp, nil
+		}
+
 		// "Computed" attributes would have been replaced with Unknown values during
 		// planning in order to allow the response from apply to return potentially
 		// different values to the ones the user configured.
@@ -289,6 +331,25 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 
 		uo := unstructured.Unstructured{}
 		uo.SetUnstructuredContent(rqObj)
+		s.mergeDefaultMetadata(&uo)
+
+		// merge the write-only values, only available from the configuration
+		woVal := confVals["manifest_wo"]
+		woFields := writeOnlyFields(woVal)
+		if len(woFields) > 0 {
+			wo, err := payload.FromTFValue(woVal, nil, tftypes.NewAttributePath().WithAttributeName("manifest_wo"))
+			if err != nil {
+				resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "Failed to convert the write-only manifest",
+					Detail:   err.Error(),
+				})
+				return resp, nil
+			}
+			if wm, ok := wo.(map[string]interface{}); ok {
+				mergeWriteOnlyPayload(uo.Object, mapRemoveNulls(wm))
+			}
+		}
 		rnamespace := uo.GetNamespace()
 		rname := uo.GetName()
 		rnn := types.NamespacedName{Namespace: rnamespace, Name: rname}.String()
@@ -372,7 +433,9 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 		defer cancel()
 
 		// Call the Kubernetes API to create the new resource
-		s.logger.Trace("[ApplyResourceChange][API Payload]: %s", jsonManifest)
+		if len(woFields) == 0 {
+			s.logger.Trace("[ApplyResourceChange][API Payload]: %s", jsonManifest)
+		}
 		result, err := rs.Patch(ctxDeadline, rname, types.ApplyPatchType, jsonManifest,
 			metav1.PatchOptions{
 				FieldManager: fieldManagerName,
@@ -403,6 +466,9 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 						Summary:  fmt.Sprintf(`PATCH for resource "%s" failed to apply`, rnn),
 					})
 			}
+			if !apierrors.IsConflict(err) && len(resp.Diagnostics) > 0 {
+				resp.Diagnostics[len(resp.Diagnostics)-1].Detail += s.describeWarningEvents(ctx, rs, rname)
+			}
 			return resp, nil
 		}
 
@@ -432,7 +498,14 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 						&tfprotov5.Diagnostic{
 							Severity: tfprotov5.DiagnosticSeverityError,
 							Summary:  "Operation timed out",
-							Detail:   reason.Error(),
+							Detail:   reason.Error() + s.describeWarningEvents(ctx, rs, rname),
+						})
+				} else if reason, ok := err.(ReadinessError); ok {
+					resp.Diagnostics = append(resp.Diagnostics,
+						&tfprotov5.Diagnostic{
+							Severity: tfprotov5.DiagnosticSeverityError,
+							Summary:  "Resource failed to become ready",
+							Detail:   reason.Error() + s.describeWarningEvents(ctx, rs, rname),
 						})
 				} else {
 					resp.Diagnostics = append(resp.Diagnostics,
@@ -458,6 +531,21 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 			result = r
 		}
 
+		// managedFields are removed from result below
+		var managedObj tftypes.Value
+		if managedFieldsOnly(plannedStateVal) {
+			managedObj, err = managedObjectValue(result, fieldManagerName, tsch, th, plannedStateVal["object"].Type())
+			if err != nil {
+				resp.Diagnostics = append(resp.Diagnostics,
+					&tfprotov5.Diagnostic{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Failed to keep the fields owned by the field manager",
+						Detail:   err.Error(),
+					})
+				return resp, nil
+			}
+		}
+
 		newResObject, err := payload.ToTFValue(RemoveServerSideFields(result.Object), tsch, th, tftypes.NewAttributePath())
 		if err != nil {
 			resp.Diagnostics = append(resp.Diagnostics,
@@ -474,7 +562,21 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 		if err != nil {
 			return resp, err
 		}
-		plannedStateVal["object"] = morph.UnknownToNull(compObj)
+		if managedFieldsOnly(plannedStateVal) {
+			compObj = managedObj
+		}
+		compObj, err = removeWriteOnlyFields(morph.UnknownToNull(compObj), woFields)
+		if err != nil {
+			return resp, err
+		}
+		plannedStateVal["object"], err = maskSensitiveFields(compObj, plannedStateVal["manifest"], sensitivePaths)
+		if err != nil {
+			return resp, err
+		}
+		resp.Private, err = privateStateWithWriteOnlyFields(woFields)
+		if err != nil {
+			return resp, err
+		}
 
 		newStateVal := tftypes.NewValue(applyPlannedState.Type(), plannedStateVal)
 		s.logger.Trace("[ApplyResourceChange][Apply]", "new state value", dump(newStateVal))
@@ -549,7 +651,42 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 		ctxDeadline, cancel := context.WithDeadline(ctx, deadline)
 		defer cancel()
 
-		err = rs.Delete(ctxDeadline, rname, metav1.DeleteOptions{})
+		if getDestroyBehavior(priorStateVal) == util.DestroyBehaviorAbandon {
+			fieldManagerName, _, err := s.getFieldManagerConfig(priorStateVal)
+			if err != nil {
+				resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "Could not extract field_manager config",
+					Detail:   err.Error(),
+				})
+				return resp, nil
+			}
+			err = util.AbandonObject(ctxDeadline, rs, util.AbandonOptions{
+				Name:         rname,
+				FieldManager: fieldManagerName,
+			})
+			if err != nil {
+				resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  fmt.Sprintf("Error abandoning resource %s", rname),
+					Detail:   err.Error(),
+				})
+			}
+			return resp, nil
+		}
+
+		deleteOptions, err := s.getDeleteOptions(priorStateVal)
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Invalid delete_options",
+				Detail:    err.Error(),
+				Attribute: tftypes.NewAttributePath().WithAttributeName("delete_options"),
+			})
+			return resp, nil
+		}
+
+		err = rs.Delete(ctxDeadline, rname, deleteOptions)
 		if err != nil {
 			if apierrors.IsNotFound(err) {
 				s.logger.Trace("[ApplyResourceChange][Delete]", "Resource is already deleted")
@@ -629,3 +766,82 @@ func (s *RawProviderServer) getTimeouts(v map[string]tftypes.Value) map[string]s
 	}
 	return timeouts
 }
+
+// getDeleteOptions merges the provider level delete options with the
+// `delete_options` block of the resource, the latter taking precedence.
+func (s *RawProviderServer) getDeleteOptions(v map[string]tftypes.Value) (metav1.DeleteOptions, error) {
+	opts := *s.deleteOptions.DeepCopy()
+	err := mergeDeleteOptionsBlock(&opts, v["delete_options"])
+	return opts, err
+}
+
+// mergeDeleteOptionsBlock overrides opts with the values set in a `delete_options` block.
+// The grace period is a string in the provider configuration and a number in the resource.
+func mergeDeleteOptionsBlock(opts *metav1.DeleteOptions, block tftypes.Value) error {
+	if block.IsNull() || !block.IsKnown() {
+		return nil
+	}
+	var items []tftypes.Value
+	if err := block.As(&items); err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	var o map[string]tftypes.Value
+	if err := items[0].As(&o); err != nil {
+		return err
+	}
+	if v, ok := o["propagation_policy"]; ok && !v.IsNull() && v.IsKnown() {
+		var p string
+		if err := v.As(&p); err != nil {
+			return err
+		}
+		policy := metav1.DeletionPropagation(p)
+		switch policy {
+		case metav1.DeletePropagationOrphan, metav1.DeletePropagationBackground, metav1.DeletePropagationForeground:
+			opts.PropagationPolicy = &policy
+		default:
+			return fmt.Errorf("invalid propagation_policy %q: must be one of %q, %q or %q", p,
+				metav1.DeletePropagationOrphan, metav1.DeletePropagationBackground, metav1.DeletePropagationForeground)
+		}
+	}
+	if v, ok := o["grace_period_seconds"]; ok && !v.IsNull() && v.IsKnown() {
+		var seconds int64
+		if v.Type().Is(tftypes.String) {
+			var gp string
+			if err := v.As(&gp); err != nil {
+				return err
+			}
+			n, err := strconv.ParseInt(gp, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid grace_period_seconds %q: %s", gp, err)
+			}
+			seconds = n
+		} else {
+			var gp big.Float
+			if err := v.As(&gp); err != nil {
+				return err
+			}
+			n, acc := gp.Int64()
+			if acc != big.Exact {
+				return fmt.Errorf("invalid grace_period_seconds %s: must be a whole number", gp.String())
+			}
+			seconds = n
+		}
+		if seconds < 0 {
+			return fmt.Errorf("invalid grace_period_seconds %d: must not be negative", seconds)
+		}
+		opts.GracePeriodSeconds = &seconds
+	}
+	return nil
+}
+
+// getDestroyBehavior returns the value of the `destroy_behavior` attribute, defaulting to "delete".
+func getDestroyBehavior(v map[string]tftypes.Value) string {
+	behavior := util.DestroyBehaviorDelete
+	if db, ok := v["destroy_behavior"]; ok && !db.IsNull() && db.IsKnown() {
+		db.As(&behavior)
+	}
+	return behavior
+}
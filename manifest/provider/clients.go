@@ -10,13 +10,14 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest/openapi"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
 
@@ -75,6 +76,17 @@ func (ps *RawProviderServer) getRestClient() (rest.Interface, error) {
 	})
 }
 
+// getClientset returns a configured typed client instance
+func (ps *RawProviderServer) getClientset() (kubernetes.Interface, error) {
+	if ps.clientConfig == nil {
+		return nil, fmt.Errorf("cannot create typed client: no client config")
+	}
+
+	return ps.clientset.Get(func() (kubernetes.Interface, error) {
+		return kubernetes.NewForConfig(ps.clientConfig)
+	})
+}
+
 // getOAPIv2Foundry returns an interface to request tftype types from an OpenAPIv2 spec
 func (ps *RawProviderServer) getOAPIv2Foundry() (openapi.Foundry, error) {
 	return ps.OAPIFoundry.Get(func() (openapi.Foundry, error) {
@@ -101,7 +113,7 @@ func (ps *RawProviderServer) getOAPIv2Foundry() (openapi.Foundry, error) {
 func loggingTransport(rt http.RoundTripper) http.RoundTripper {
 	return &loggingRountTripper{
 		ot: rt,
-		lt: logging.NewSubsystemLoggingHTTPTransport("Kubernetes API", rt),
+		lt: util.NewRedactingLoggingTransport("Kubernetes API", rt),
 	}
 }
 
@@ -153,3 +165,26 @@ func (ps *RawProviderServer) checkValidCredentials(ctx context.Context) []*tfpro
 
 	return diagnostics
 }
+
+// waitForAPIServerReady blocks until the API server reports ready on its "/readyz" endpoint
+// or the timeout expires, in which case the failing readiness checks are returned as a diagnostic
+func (ps *RawProviderServer) waitForAPIServerReady(ctx context.Context, timeout time.Duration) []*tfprotov5.Diagnostic {
+	rc, err := ps.getRestClient()
+	if err != nil {
+		return []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to construct REST client",
+			Detail:   err.Error(),
+		}}
+	}
+	ps.logger.Info("[Configure]", "Waiting for API server to become ready, timeout", timeout.String())
+	err = util.WaitForAPIServerReady(ctx, rc, timeout)
+	if err != nil {
+		return []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Kubernetes API server is not ready",
+			Detail:   err.Error(),
+		}}
+	}
+	return nil
+}
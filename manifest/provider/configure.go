@@ -13,11 +13,14 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
 	"github.com/mitchellh/go-homedir"
 	"golang.org/x/mod/semver"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -474,6 +477,135 @@ func (s *RawProviderServer) ConfigureProvider(ctx context.Context, req *tfprotov
 		overrides.ClusterDefaults.ProxyURL = proxyURL
 	}
 
+	var namespace string
+	if !providerConfig["namespace"].IsNull() && providerConfig["namespace"].IsKnown() {
+		err = providerConfig["namespace"].As(&namespace)
+		if err != nil {
+			// invalid attribute type - this shouldn't happen, bail out for now
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Provider configuration: failed to assert type of 'namespace' value",
+				Detail:   err.Error(),
+			})
+			return response, nil
+		}
+	}
+
+	var startupTimeout time.Duration
+	if !providerConfig["startup_timeout"].IsNull() && providerConfig["startup_timeout"].IsKnown() {
+		var st string
+		err = providerConfig["startup_timeout"].As(&st)
+		if err != nil {
+			// invalid attribute type - this shouldn't happen, bail out for now
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Provider configuration: failed to assert type of 'startup_timeout' value",
+				Detail:   err.Error(),
+			})
+			return response, nil
+		}
+		startupTimeout, err = time.ParseDuration(st)
+		if err != nil {
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityInvalid,
+				Summary:  "Invalid attribute in provider configuration",
+				Detail:   "Invalid value for 'startup_timeout': " + err.Error(),
+			})
+			return response, nil
+		}
+	}
+
+	// Handle 'delete_options' block
+	//
+	s.deleteOptions = metav1.DeleteOptions{}
+	err = mergeDeleteOptionsBlock(&s.deleteOptions, providerConfig["delete_options"])
+	if err != nil {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityInvalid,
+			Summary:  "Invalid attribute in provider configuration",
+			Detail:   "Invalid 'delete_options' block: " + err.Error(),
+		})
+		return response, nil
+	}
+
+	// Handle 'read_only' attribute
+	//
+	s.readOnly = false
+	if !providerConfig["read_only"].IsNull() && providerConfig["read_only"].IsKnown() {
+		err = providerConfig["read_only"].As(&s.readOnly)
+		if err != nil {
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Provider configuration: failed to assert type of 'read_only' value",
+				Detail:   err.Error(),
+			})
+			return response, nil
+		}
+	}
+
+	// Handle 'protected_namespaces', 'protected_kinds' and 'protection_mode' attributes
+	//
+	protectedNamespaces, err := stringListFromValue(providerConfig["protected_namespaces"])
+	if err != nil {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Provider configuration: failed to assert type of 'protected_namespaces' value",
+			Detail:   err.Error(),
+		})
+		return response, nil
+	}
+	protectedKinds, err := stringListFromValue(providerConfig["protected_kinds"])
+	if err != nil {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Provider configuration: failed to assert type of 'protected_kinds' value",
+			Detail:   err.Error(),
+		})
+		return response, nil
+	}
+	var protectionMode string
+	if !providerConfig["protection_mode"].IsNull() && providerConfig["protection_mode"].IsKnown() {
+		err = providerConfig["protection_mode"].As(&protectionMode)
+		if err != nil {
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Provider configuration: failed to assert type of 'protection_mode' value",
+				Detail:   err.Error(),
+			})
+			return response, nil
+		}
+	}
+	s.protection, err = util.NewProtection(protectedNamespaces, protectedKinds, protectionMode)
+	if err != nil {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityInvalid,
+			Summary:  "Invalid attribute in provider configuration",
+			Detail:   err.Error(),
+		})
+		return response, nil
+	}
+
+	// Handle 'default_labels' and 'default_annotations' attributes
+	//
+	s.defaultLabels, err = stringMapFromValue(providerConfig["default_labels"])
+	if err != nil {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Provider configuration: failed to assert type of 'default_labels' value",
+			Detail:   err.Error(),
+		})
+		return response, nil
+	}
+	s.defaultAnnotations, err = stringMapFromValue(providerConfig["default_annotations"])
+	if err != nil {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Provider configuration: failed to assert type of 'default_annotations' value",
+			Detail:   err.Error(),
+		})
+		return response, nil
+	}
+
 	if !providerConfig["exec"].IsNull() && providerConfig["exec"].IsKnown() {
 		var execBlock []tftypes.Value
 		err = providerConfig["exec"].As(&execBlock)
@@ -591,6 +723,12 @@ func (s *RawProviderServer) ConfigureProvider(ctx context.Context, req *tfprotov
 	}
 
 	cc := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loader, overrides)
+
+	s.namespace = namespace
+	if s.namespace == "" {
+		s.namespace = util.ContextNamespace(cc, overrides.CurrentContext)
+	}
+
 	clientConfig, err := cc.ClientConfig()
 	if err != nil {
 		s.logger.Error("[Configure]", "Failed to load config:", dump(cc))
@@ -606,16 +744,26 @@ func (s *RawProviderServer) ConfigureProvider(ctx context.Context, req *tfprotov
 		return response, nil
 	}
 
+	// Warnings returned by the API server are collected per operation and
+	// surfaced as warning diagnostics rather than being logged by client-go.
+	clientConfig.WarningHandler = rest.NoWarnings{}
+	clientConfig.Wrap(util.WarningTransport)
+
 	if s.logger.IsTrace() {
-		clientConfig.WrapTransport = loggingTransport
+		clientConfig.Wrap(loggingTransport)
 	}
 
 	codec := runtime.NoopEncoder{Decoder: scheme.Codecs.UniversalDecoder()}
 	clientConfig.NegotiatedSerializer = serializer.NegotiatedSerializerWrapper(runtime.SerializerInfo{Serializer: codec})
 
-	s.logger.Trace("[Configure]", "[ClientConfig]", dump(*clientConfig))
+	// dumping the pointer has Config.String redact the credentials
+	s.logger.Trace("[Configure]", "[ClientConfig]", dump(clientConfig))
 	s.clientConfig = clientConfig
 
+	if startupTimeout > 0 && !s.clientConfigUnknown {
+		response.Diagnostics = append(response.Diagnostics, s.waitForAPIServerReady(ctx, startupTimeout)...)
+	}
+
 	return response, nil
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest/morph"
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest/payload"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -42,6 +43,9 @@ func (s *RawProviderServer) ReadPluralDataSource(ctx context.Context, req *tfpro
 
 	resp := &tfprotov5.ReadDataSourceResponse{}
 
+	ctx, wc := util.ContextWithWarningCollector(ctx)
+	defer func() { resp.Diagnostics = append(resp.Diagnostics, APIWarningsToDiagnostics(wc)...) }()
+
 	execDiag := s.canExecute()
 	if len(execDiag) > 0 {
 		resp.Diagnostics = append(resp.Diagnostics, execDiag...)
@@ -154,7 +158,7 @@ func (s *RawProviderServer) ReadPluralDataSource(ctx context.Context, req *tfpro
 		var namespace string
 		dsConfig["namespace"].As(&namespace)
 		if namespace == "" {
-			namespace = "default"
+			namespace = s.namespace
 		}
 		res, err = rcl.Namespace(namespace).List(ctx, listOptions)
 	} else {
@@ -237,6 +241,9 @@ func (s *RawProviderServer) ReadSingularDataSource(ctx context.Context, req *tfp
 
 	resp := &tfprotov5.ReadDataSourceResponse{}
 
+	ctx, wc := util.ContextWithWarningCollector(ctx)
+	defer func() { resp.Diagnostics = append(resp.Diagnostics, APIWarningsToDiagnostics(wc)...) }()
+
 	execDiag := s.canExecute()
 	if len(execDiag) > 0 {
 		resp.Diagnostics = append(resp.Diagnostics, execDiag...)
@@ -345,7 +352,7 @@ func (s *RawProviderServer) ReadSingularDataSource(ctx context.Context, req *tfp
 		var namespace string
 		metadata["namespace"].As(&namespace)
 		if namespace == "" {
-			namespace = "default"
+			namespace = s.namespace
 		}
 		res, err = rcl.Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
 	} else {
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// stringMapFromValue converts a map of strings from the provider configuration.
+func stringMapFromValue(v tftypes.Value) (map[string]string, error) {
+	if v.IsNull() || !v.IsKnown() {
+		return nil, nil
+	}
+	var vals map[string]tftypes.Value
+	if err := v.As(&vals); err != nil {
+		return nil, err
+	}
+	m := make(map[string]string, len(vals))
+	for k, e := range vals {
+		var s string
+		if err := e.As(&s); err != nil {
+			return nil, err
+		}
+		m[k] = s
+	}
+	return m, nil
+}
+
+// withDefaultMetadata adds the provider default labels and annotations that
+// are not set in the manifest to its metadata.
+func (s *RawProviderServer) withDefaultMetadata(man tftypes.Value) (tftypes.Value, error) {
+	if len(s.defaultLabels) == 0 && len(s.defaultAnnotations) == 0 {
+		return man, nil
+	}
+	if man.IsNull() || !man.IsKnown() || !man.Type().Is(tftypes.Object{}) {
+		return man, nil
+	}
+	var atts map[string]tftypes.Value
+	if err := man.As(&atts); err != nil {
+		return man, err
+	}
+	md, ok := atts["metadata"]
+	if !ok || md.IsNull() || !md.IsKnown() || !md.Type().Is(tftypes.Object{}) {
+		return man, nil
+	}
+	var mdAtts map[string]tftypes.Value
+	if err := md.As(&mdAtts); err != nil {
+		return man, err
+	}
+	for k, defaults := range map[string]map[string]string{
+		"labels":      s.defaultLabels,
+		"annotations": s.defaultAnnotations,
+	} {
+		v, ok, err := mergeDefaultKeys(mdAtts[k], defaults)
+		if err != nil {
+			return man, err
+		}
+		if ok {
+			mdAtts[k] = v
+		}
+	}
+	atts["metadata"] = newObjectValue(mdAtts)
+	return newObjectValue(atts), nil
+}
+
+// withDefaultNamespace sets the namespace of a namespaced manifest that does not
+// set one. An object already in state keeps its namespace, so that changing the
+// provider namespace does not move it.
+func (s *RawProviderServer) withDefaultNamespace(man, priorObj tftypes.Value) (tftypes.Value, error) {
+	namespace := s.namespace
+	if ns := namespaceFromValue(priorObj); ns != "" {
+		namespace = ns
+	}
+	if namespace == "" || man.IsNull() || !man.IsKnown() || !man.Type().Is(tftypes.Object{}) {
+		return man, nil
+	}
+	var atts map[string]tftypes.Value
+	if err := man.As(&atts); err != nil {
+		return man, err
+	}
+	md, ok := atts["metadata"]
+	if !ok || md.IsNull() || !md.IsKnown() || !md.Type().Is(tftypes.Object{}) {
+		return man, nil
+	}
+	var mdAtts map[string]tftypes.Value
+	if err := md.As(&mdAtts); err != nil {
+		return man, err
+	}
+	if v, ok := mdAtts["namespace"]; ok && (!v.IsKnown() || namespaceFromValue(v) != "") {
+		return man, nil
+	}
+	mdAtts["namespace"] = tftypes.NewValue(tftypes.String, namespace)
+	atts["metadata"] = newObjectValue(mdAtts)
+	return newObjectValue(atts), nil
+}
+
+// namespaceFromValue returns the namespace of an object, or the value of a
+// namespace attribute, and an empty string when it is not set.
+func namespaceFromValue(v tftypes.Value) string {
+	if v.Type() == nil || v.IsNull() || !v.IsKnown() {
+		return ""
+	}
+	if v.Type().Is(tftypes.Object{}) {
+		nv, rest, err := tftypes.WalkAttributePath(v, tftypes.NewAttributePath().WithAttributeName("metadata").WithAttributeName("namespace"))
+		if err != nil || len(rest.Steps()) > 0 {
+			return ""
+		}
+		return namespaceFromValue(nv.(tftypes.Value))
+	}
+	var ns string
+	if !v.Type().Is(tftypes.String) || v.As(&ns) != nil {
+		return ""
+	}
+	return ns
+}
+
+// mergeDefaultKeys returns v with the default keys it does not set. The
+// boolean result is false when v cannot hold the defaults and is left as is.
+func mergeDefaultKeys(v tftypes.Value, defaults map[string]string) (tftypes.Value, bool, error) {
+	if len(defaults) == 0 {
+		return v, false, nil
+	}
+	vals := map[string]tftypes.Value{}
+	if v.Type() != nil && !v.IsNull() {
+		if !v.IsKnown() || !(v.Type().Is(tftypes.Object{}) || v.Type().Is(tftypes.Map{ElementType: tftypes.String})) {
+			return v, false, nil
+		}
+		if err := v.As(&vals); err != nil {
+			return v, false, err
+		}
+	}
+	for k, d := range defaults {
+		if _, ok := vals[k]; !ok {
+			vals[k] = tftypes.NewValue(tftypes.String, d)
+		}
+	}
+	if v.Type() != nil && v.Type().Is(tftypes.Map{}) {
+		return tftypes.NewValue(v.Type(), vals), true, nil
+	}
+	return newObjectValue(vals), true, nil
+}
+
+func newObjectValue(atts map[string]tftypes.Value) tftypes.Value {
+	types := make(map[string]tftypes.Type, len(atts))
+	for k, v := range atts {
+		types[k] = v.Type()
+	}
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: types}, atts)
+}
+
+// mergeDefaultMetadata adds the provider default labels and annotations that
+// are not set on the object.
+func (s *RawProviderServer) mergeDefaultMetadata(uo *unstructured.Unstructured) {
+	if labels := mergeStringMaps(s.defaultLabels, uo.GetLabels()); len(labels) > 0 {
+		uo.SetLabels(labels)
+	}
+	if annotations := mergeStringMaps(s.defaultAnnotations, uo.GetAnnotations()); len(annotations) > 0 {
+		uo.SetAnnotations(annotations)
+	}
+}
+
+func mergeStringMaps(defaults, m map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return m
+	}
+	result := make(map[string]string, len(defaults)+len(m))
+	for k, v := range defaults {
+		result[k] = v
+	}
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
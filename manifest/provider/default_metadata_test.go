@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWithDefaultMetadata(t *testing.T) {
+	s := &RawProviderServer{
+		defaultLabels:      map[string]string{"team": "platform", "cost-center": "42"},
+		defaultAnnotations: map[string]string{"owner": "platform"},
+	}
+	man := newObjectValue(map[string]tftypes.Value{
+		"apiVersion": tftypes.NewValue(tftypes.String, "v1"),
+		"kind":       tftypes.NewValue(tftypes.String, "ConfigMap"),
+		"metadata": newObjectValue(map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "test"),
+			"labels": newObjectValue(map[string]tftypes.Value{
+				"team": tftypes.NewValue(tftypes.String, "payments"),
+			}),
+		}),
+	})
+	expected := newObjectValue(map[string]tftypes.Value{
+		"apiVersion": tftypes.NewValue(tftypes.String, "v1"),
+		"kind":       tftypes.NewValue(tftypes.String, "ConfigMap"),
+		"metadata": newObjectValue(map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "test"),
+			"labels": newObjectValue(map[string]tftypes.Value{
+				"team":        tftypes.NewValue(tftypes.String, "payments"),
+				"cost-center": tftypes.NewValue(tftypes.String, "42"),
+			}),
+			"annotations": newObjectValue(map[string]tftypes.Value{
+				"owner": tftypes.NewValue(tftypes.String, "platform"),
+			}),
+		}),
+	})
+
+	out, err := s.withDefaultMetadata(man)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !out.Equal(expected) {
+		t.Errorf("expected %s got %s", expected, out)
+	}
+
+	unknown := newObjectValue(map[string]tftypes.Value{
+		"metadata": tftypes.NewValue(tftypes.Object{}, tftypes.UnknownValue),
+	})
+	out, err = s.withDefaultMetadata(unknown)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != unknown.String() {
+		t.Errorf("expected unknown metadata to be left as is, got %s", out)
+	}
+}
+
+func TestWithDefaultNamespace(t *testing.T) {
+	s := &RawProviderServer{namespace: "team-a"}
+	manifest := func(md map[string]tftypes.Value) tftypes.Value {
+		return newObjectValue(map[string]tftypes.Value{
+			"apiVersion": tftypes.NewValue(tftypes.String, "v1"),
+			"kind":       tftypes.NewValue(tftypes.String, "ConfigMap"),
+			"metadata":   newObjectValue(md),
+		})
+	}
+	name := tftypes.NewValue(tftypes.String, "test")
+
+	cases := map[string]struct {
+		man      tftypes.Value
+		prior    tftypes.Value
+		expected tftypes.Value
+	}{
+		"provider namespace": {
+			man: manifest(map[string]tftypes.Value{"name": name}),
+			expected: manifest(map[string]tftypes.Value{
+				"name":      name,
+				"namespace": tftypes.NewValue(tftypes.String, "team-a"),
+			}),
+		},
+		"manifest namespace": {
+			man: manifest(map[string]tftypes.Value{
+				"name":      name,
+				"namespace": tftypes.NewValue(tftypes.String, "other"),
+			}),
+			expected: manifest(map[string]tftypes.Value{
+				"name":      name,
+				"namespace": tftypes.NewValue(tftypes.String, "other"),
+			}),
+		},
+		"namespace in state": {
+			man: manifest(map[string]tftypes.Value{"name": name}),
+			prior: manifest(map[string]tftypes.Value{
+				"name":      name,
+				"namespace": tftypes.NewValue(tftypes.String, "previous"),
+			}),
+			expected: manifest(map[string]tftypes.Value{
+				"name":      name,
+				"namespace": tftypes.NewValue(tftypes.String, "previous"),
+			}),
+		},
+		"unknown namespace": {
+			man: manifest(map[string]tftypes.Value{
+				"name":      name,
+				"namespace": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: manifest(map[string]tftypes.Value{
+				"name":      name,
+				"namespace": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			out, err := s.withDefaultNamespace(tc.man, tc.prior)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out.String() != tc.expected.String() {
+				t.Errorf("expected %s got %s", tc.expected, out)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -35,3 +36,17 @@ func APIStatusErrorToDiagnostics(s metav1.Status) []*tfprotov5.Diagnostic {
 	}
 	return diags
 }
+
+// APIWarningsToDiagnostics converts the warnings returned by the API server during
+// an operation into Terraform warning diagnostics
+func APIWarningsToDiagnostics(wc *util.WarningCollector) []*tfprotov5.Diagnostic {
+	var diags []*tfprotov5.Diagnostic
+	for _, w := range wc.Warnings() {
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "Kubernetes API server warning",
+			Detail:   w,
+		})
+	}
+	return diags
+}
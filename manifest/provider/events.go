@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+// describeTimeout bounds the calls made to describe a failure, which can
+// happen once the context of the operation is done
+const describeTimeout = 30 * time.Second
+
+// ownedPods returns the pods controlled by obj, through its ReplicaSets for a
+// Deployment, along with the UIDs of obj and of the ReplicaSets walked.
+// Objects without a label selector in their spec own no pods.
+func ownedPods(ctx context.Context, clientset kubernetes.Interface, obj *unstructured.Unstructured) ([]corev1.Pod, map[types.UID]bool, error) {
+	var selector *v1.LabelSelector
+	if s, ok, _ := unstructured.NestedMap(obj.Object, "spec", "selector"); ok {
+		selector = &v1.LabelSelector{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(s, selector); err != nil {
+			// not a label selector, like the selector of a Service
+			selector = nil
+		}
+	}
+	return util.WorkloadPods(ctx, clientset, obj.GetNamespace(), obj.GetUID(), obj.GetKind(), selector)
+}
+
+// describeWarningEvents returns the latest distinct Warning events of the
+// object rname and of the ReplicaSets and Pods it owns, formatted to be added
+// to the detail of a diagnostic.
+func (s *RawProviderServer) describeWarningEvents(ctx context.Context, rs dynamic.ResourceInterface, rname string) string {
+	clientset, err := s.getClientset()
+	if err != nil {
+		return ""
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), describeTimeout)
+	defer cancel()
+
+	obj, err := rs.Get(ctx, rname, v1.GetOptions{})
+	if err != nil {
+		// the object was not created
+		return ""
+	}
+	pods, uids, err := ownedPods(ctx, clientset, obj)
+	if err != nil {
+		s.logger.Debug("[Events] Could not list the pods of the resource", "error", err)
+	}
+	for _, p := range pods {
+		uids[p.UID] = true
+	}
+	events, err := util.LastWarningEvents(ctx, clientset, obj.GetNamespace(), uids, util.FailureWarningsLimit)
+	if err != nil {
+		s.logger.Debug("[Events] Could not list the events of the resource", "error", err)
+		return ""
+	}
+	if len(events) == 0 {
+		return ""
+	}
+	return "\n\nLast warnings:" + util.StringifyEvents(events)
+}
@@ -26,6 +26,9 @@ func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprot
 	// Presumably the Kubernetes API machinery already has a standard for expressing such a group. We should look there first.
 	resp := &tfprotov5.ImportResourceStateResponse{}
 
+	ctx, wc := util.ContextWithWarningCollector(ctx)
+	defer func() { resp.Diagnostics = append(resp.Diagnostics, APIWarningsToDiagnostics(wc)...) }()
+
 	cp := req.ClientCapabilities
 	if cp != nil && cp.DeferralAllowed && s.clientConfigUnknown {
 		v := tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue)
@@ -54,9 +57,9 @@ func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprot
 	var name, namespace string
 	var err error
 	if req.Identity != nil {
-		gvk, name, namespace, err = parseResourceIdentityData(req.Identity)
+		gvk, name, namespace, err = parseResourceIdentityData(req.Identity, s.namespace)
 	} else {
-		gvk, name, namespace, err = util.ParseResourceID(req.ID)
+		gvk, name, namespace, err = util.ParseResourceID(req.ID, s.namespace)
 	}
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
@@ -182,6 +185,10 @@ func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprot
 	newState["timeouts"] = tftypes.NewValue(timeoutsType, nil)
 	newState["field_manager"] = tftypes.NewValue(fmType, nil)
 	newState["computed_fields"] = tftypes.NewValue(cmpType, nil)
+	newState["manifest_wo"] = tftypes.NewValue(tftypes.DynamicPseudoType, nil)
+	newState["manifest_wo_revision"] = tftypes.NewValue(tftypes.Number, nil)
+	newState["managed_fields_only"] = tftypes.NewValue(tftypes.Bool, nil)
+	newState["sensitive_fields"] = tftypes.NewValue(rt.(tftypes.Object).AttributeTypes["sensitive_fields"], nil)
 
 	nsVal := tftypes.NewValue(rt, newState)
 
@@ -195,7 +202,10 @@ func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprot
 		return resp, nil
 	}
 	impf := tftypes.NewValue(privateStateSchema,
-		map[string]tftypes.Value{"IsImported": tftypes.NewValue(tftypes.Bool, true)},
+		map[string]tftypes.Value{
+			"IsImported":      tftypes.NewValue(tftypes.Bool, true),
+			"WriteOnlyFields": tftypes.NewValue(privateStateSchema.AttributeTypes["WriteOnlyFields"], nil),
+		},
 	)
 	fb, err := impf.MarshalMsgPack(privateStateSchema)
 	if err != nil {
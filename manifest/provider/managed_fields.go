@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-kubernetes/manifest/payload"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// managedFieldsOnly reports whether `object` only holds the fields owned by
+// the Terraform field manager.
+func managedFieldsOnly(vals map[string]tftypes.Value) bool {
+	v, ok := vals["managed_fields_only"]
+	if !ok || v.IsNull() || !v.IsKnown() {
+		return false
+	}
+	var b bool
+	_ = v.As(&b)
+	return b
+}
+
+// managedObjectValue converts the fields of obj owned by fieldManager to the
+// type of `object` planned from the manifest.
+func managedObjectValue(obj *unstructured.Unstructured, fieldManager string, objectType tftypes.Type, th map[string]string, planned tftypes.Type) (tftypes.Value, error) {
+	owned := util.ManagedFieldsOnly(obj, fieldManager)
+	v, err := payload.ToTFValue(owned, objectType, th, tftypes.NewAttributePath())
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	return projectToType(v, planned), nil
+}
+
+func isPrimitiveType(t tftypes.Type) bool {
+	return t.Is(tftypes.String) || t.Is(tftypes.Number) || t.Is(tftypes.Bool)
+}
+
+// pruneNullAttributes removes the null attributes of the objects in v, so that
+// `object` only has the fields set in the manifest. Lists and maps of objects
+// become tuples and objects, as their elements can keep different attributes.
+func pruneNullAttributes(v tftypes.Value) (tftypes.Value, error) {
+	if v.IsNull() || !v.IsKnown() {
+		return v, nil
+	}
+	switch t := v.Type().(type) {
+	case tftypes.Object:
+		var atts map[string]tftypes.Value
+		if err := v.As(&atts); err != nil {
+			return v, err
+		}
+		return pruneElements(atts, true)
+	case tftypes.Map:
+		var elems map[string]tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return v, err
+		}
+		if !isPrimitiveType(t.ElementType) {
+			return pruneElements(elems, false)
+		}
+		kept := make(map[string]tftypes.Value, len(elems))
+		for k, e := range elems {
+			if !e.IsNull() {
+				kept[k] = e
+			}
+		}
+		return tftypes.NewValue(t, kept), nil
+	case tftypes.List, tftypes.Tuple:
+		if l, ok := t.(tftypes.List); ok && isPrimitiveType(l.ElementType) {
+			return v, nil
+		}
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return v, err
+		}
+		vals := make([]tftypes.Value, len(elems))
+		types := make([]tftypes.Type, len(elems))
+		for i, e := range elems {
+			pe, err := pruneNullAttributes(e)
+			if err != nil {
+				return v, err
+			}
+			vals[i] = pe
+			types[i] = pe.Type()
+		}
+		return tftypes.NewValue(tftypes.Tuple{ElementTypes: types}, vals), nil
+	}
+	return v, nil
+}
+
+func pruneElements(elems map[string]tftypes.Value, skipNull bool) (tftypes.Value, error) {
+	vals := make(map[string]tftypes.Value, len(elems))
+	types := make(map[string]tftypes.Type, len(elems))
+	for k, e := range elems {
+		if skipNull && e.IsNull() {
+			continue
+		}
+		pe, err := pruneNullAttributes(e)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		vals[k] = pe
+		types[k] = pe.Type()
+	}
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: types}, vals), nil
+}
+
+// projectToType converts v, a value of the full type of the resource, to the
+// type t of a pruned `object`. Parts of v that t has no room for are dropped
+// and parts of t missing from v are null.
+func projectToType(v tftypes.Value, t tftypes.Type) tftypes.Value {
+	if v.Type() == nil || v.IsNull() || !v.IsKnown() {
+		return tftypes.NewValue(t, nil)
+	}
+	switch tt := t.(type) {
+	case tftypes.Object:
+		var src map[string]tftypes.Value
+		if !(v.Type().Is(tftypes.Object{}) || v.Type().Is(tftypes.Map{})) || v.As(&src) != nil {
+			return tftypes.NewValue(t, nil)
+		}
+		vals := make(map[string]tftypes.Value, len(tt.AttributeTypes))
+		for k, at := range tt.AttributeTypes {
+			vals[k] = projectToType(src[k], at)
+		}
+		return tftypes.NewValue(t, vals)
+	case tftypes.Tuple:
+		var src []tftypes.Value
+		if !(v.Type().Is(tftypes.List{}) || v.Type().Is(tftypes.Tuple{})) || v.As(&src) != nil {
+			return tftypes.NewValue(t, nil)
+		}
+		vals := make([]tftypes.Value, len(tt.ElementTypes))
+		for i, et := range tt.ElementTypes {
+			var e tftypes.Value
+			if i < len(src) {
+				e = src[i]
+			}
+			vals[i] = projectToType(e, et)
+		}
+		return tftypes.NewValue(t, vals)
+	case tftypes.Map:
+		var src map[string]tftypes.Value
+		if !v.Type().Is(tftypes.Map{}) || v.As(&src) != nil {
+			return tftypes.NewValue(t, nil)
+		}
+		vals := make(map[string]tftypes.Value, len(src))
+		for k, e := range src {
+			vals[k] = projectToType(e, tt.ElementType)
+		}
+		return tftypes.NewValue(t, vals)
+	case tftypes.List:
+		var src []tftypes.Value
+		if !v.Type().Is(tftypes.List{}) || v.As(&src) != nil {
+			return tftypes.NewValue(t, nil)
+		}
+		vals := make([]tftypes.Value, len(src))
+		for i, e := range src {
+			vals[i] = projectToType(e, tt.ElementType)
+		}
+		return tftypes.NewValue(t, vals)
+	}
+	if t.Is(tftypes.DynamicPseudoType) || v.Type().Equal(t) {
+		return v
+	}
+	return tftypes.NewValue(t, nil)
+}
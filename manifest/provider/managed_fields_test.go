@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPruneAndProjectManagedFields(t *testing.T) {
+	containerType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":            tftypes.String,
+		"image":           tftypes.String,
+		"imagePullPolicy": tftypes.String,
+	}}
+	specType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"replicas":             tftypes.Number,
+		"revisionHistoryLimit": tftypes.Number,
+		"containers":           tftypes.List{ElementType: containerType},
+	}}
+	container := func(name, image, policy interface{}) tftypes.Value {
+		return tftypes.NewValue(containerType, map[string]tftypes.Value{
+			"name":            tftypes.NewValue(tftypes.String, name),
+			"image":           tftypes.NewValue(tftypes.String, image),
+			"imagePullPolicy": tftypes.NewValue(tftypes.String, policy),
+		})
+	}
+	spec := func(history interface{}, containers ...tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(specType, map[string]tftypes.Value{
+			"replicas":             tftypes.NewValue(tftypes.Number, 3),
+			"revisionHistoryLimit": tftypes.NewValue(tftypes.Number, history),
+			"containers":           tftypes.NewValue(tftypes.List{ElementType: containerType}, containers),
+		})
+	}
+
+	// the manifest, morphed to the type of the resource
+	manifest := spec(nil, container("web", "nginx", nil))
+	pruned, err := pruneNullAttributes(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"replicas": tftypes.Number,
+		"containers": tftypes.Tuple{ElementTypes: []tftypes.Type{
+			tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"name":  tftypes.String,
+				"image": tftypes.String,
+			}},
+		}},
+	}}
+	if !pruned.Type().Equal(expectedType) {
+		t.Fatalf("expected type %s got %s", expectedType, pruned.Type())
+	}
+
+	// the object returned by the API server has defaulted fields
+	full := spec(10, container("web", "nginx", "Always"))
+	if projected := projectToType(full, pruned.Type()); !projected.Equal(pruned) {
+		t.Errorf("expected %v got %v", pruned, projected)
+	}
+
+	// a field no longer owned by Terraform shows as a difference
+	drifted := spec(10, container("web", nil, "Always"))
+	if projected := projectToType(drifted, pruned.Type()); projected.Equal(pruned) {
+		t.Error("expected the missing image to show as a difference")
+	}
+}
+
+func TestConfigurationChanged(t *testing.T) {
+	manifest := func(labels map[string]tftypes.Value) tftypes.Value {
+		return newObjectValue(map[string]tftypes.Value{
+			"metadata": newObjectValue(map[string]tftypes.Value{
+				"name":   tftypes.NewValue(tftypes.String, "app"),
+				"labels": newObjectValue(labels),
+			}),
+		})
+	}
+	labels := tftypes.NewAttributePath().WithAttributeName("metadata").WithAttributeName("labels")
+	prior := manifest(map[string]tftypes.Value{"app": tftypes.NewValue(tftypes.String, "web")})
+
+	if configurationChanged(prior, manifest(map[string]tftypes.Value{"app": tftypes.NewValue(tftypes.String, "web")}), labels) {
+		t.Error("expected unchanged labels")
+	}
+	if !configurationChanged(prior, manifest(map[string]tftypes.Value{"app": tftypes.NewValue(tftypes.String, "api")}), labels) {
+		t.Error("expected changed labels")
+	}
+	unset := newObjectValue(map[string]tftypes.Value{
+		"metadata": newObjectValue(map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "app"),
+		}),
+	})
+	if !configurationChanged(unset, prior, labels) {
+		t.Error("expected labels set in the configuration to be a change")
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest"
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest/morph"
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest/payload"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -130,6 +131,9 @@ func isImportedFlagFromPrivate(p []byte) (f bool, d []*tfprotov5.Diagnostic) {
 func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
 	resp := &tfprotov5.PlanResourceChangeResponse{}
 
+	ctx, wc := util.ContextWithWarningCollector(ctx)
+	defer func() { resp.Diagnostics = append(resp.Diagnostics, APIWarningsToDiagnostics(wc)...) }()
+
 	rt, err := GetResourceType(req.TypeName)
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
@@ -162,6 +166,9 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 		return resp, nil
 	}
 
+	// write-only values are never part of the plan
+	proposedVal["manifest_wo"] = tftypes.NewValue(tftypes.DynamicPseudoType, nil)
+
 	canDeferr := req.ClientCapabilities != nil && req.ClientCapabilities.DeferralAllowed
 
 	if canDeferr && s.clientConfigUnknown {
@@ -285,6 +292,18 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 		return resp, nil
 	}
 
+	// the object is planned with the provider default labels and annotations
+	objMan, err := s.withDefaultMetadata(ppMan)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Failed to add default labels and annotations to manifest",
+			Detail:    err.Error(),
+			Attribute: tftypes.NewAttributePath().WithAttributeName("manifest").WithAttributeName("metadata"),
+		})
+		return resp, nil
+	}
+
 	rm, err := s.getRestMapper()
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
@@ -312,12 +331,6 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 		return resp, nil
 	}
 
-	vdiags := s.validateResourceOnline(&ppMan)
-	if len(vdiags) > 0 {
-		resp.Diagnostics = append(resp.Diagnostics, vdiags...)
-		return resp, nil
-	}
-
 	ns, err := IsResourceNamespaced(gvk, rm)
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
@@ -327,6 +340,25 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 		})
 		return resp, nil
 	}
+	if ns {
+		objMan, err = s.withDefaultNamespace(objMan, priorVal["object"])
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Failed to add the provider namespace to manifest",
+				Detail:    err.Error(),
+				Attribute: tftypes.NewAttributePath().WithAttributeName("manifest").WithAttributeName("metadata"),
+			})
+			return resp, nil
+		}
+	}
+
+	vdiags := s.validateResourceOnline(&objMan)
+	if len(vdiags) > 0 {
+		resp.Diagnostics = append(resp.Diagnostics, vdiags...)
+		return resp, nil
+	}
+
 	if ns && !isImported {
 		resp.RequiresReplace = append(resp.RequiresReplace,
 			tftypes.NewAttributePath().WithAttributeName("manifest").WithAttributeName("metadata").WithAttributeName("namespace"),
@@ -342,7 +374,7 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 	if !objectType.Is(tftypes.Object{}) {
 		// non-structural resources have no schema so we just use the
 		// type information we can get from the config
-		objectType = ppMan.Type()
+		objectType = objMan.Type()
 
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
 			Severity: tfprotov5.DiagnosticSeverityWarning,
@@ -360,7 +392,7 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 			return resp, nil
 		}
 
-		err = s.dryRun(ctx, ppMan, fieldManagerName, forceConflicts, ns)
+		err = s.dryRun(ctx, objMan, fieldManagerName, forceConflicts, ns)
 		if err != nil {
 			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
 				Severity: tfprotov5.DiagnosticSeverityError,
@@ -380,7 +412,7 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 	s.logger.Debug("[PlanUpdateResource]", "OAPI type", dump(so))
 
 	// Transform the input manifest to adhere to the type model from the OpenAPI spec
-	morphedManifest, d := morph.ValueToType(ppMan, objectType, tftypes.NewAttributePath().WithAttributeName("object"))
+	morphedManifest, d := morph.ValueToType(objMan, objectType, tftypes.NewAttributePath().WithAttributeName("object"))
 	if len(d) > 0 {
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
 			Severity: tfprotov5.DiagnosticSeverityError,
@@ -404,7 +436,36 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 	}
 	s.logger.Debug("[PlanResourceChange]", "backfilled manifest", dump(completePropMan))
 
-	if proposedVal["object"].IsNull() {
+	if managedFieldsOnly(proposedVal) {
+		// only keep the fields set in the manifest, owned by the field manager
+		prunedObj, err := pruneNullAttributes(morphedManifest)
+		if err == nil {
+			prunedObj, err = tftypes.Transform(prunedObj, func(ap *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+				if _, ok := computedFields[ap.String()]; !ok {
+					return v, nil
+				}
+				// like for an update below, a computed field is only unknown when its configuration changed
+				priorObj, ok := priorVal["object"]
+				if ok && !priorObj.IsNull() && !configurationChanged(priorVal["manifest"], ppMan, ap) {
+					priorAtrVal, restPath, err := tftypes.WalkAttributePath(priorObj, ap)
+					if err == nil && len(restPath.Steps()) == 0 {
+						return priorAtrVal.(tftypes.Value), nil
+					}
+				}
+				return tftypes.NewValue(v.Type(), tftypes.UnknownValue), nil
+			})
+		}
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Failed to prune unmanaged attributes from proposed state",
+				Detail:    err.Error(),
+				Attribute: tftypes.NewAttributePath().WithAttributeName("object"),
+			})
+			return resp, nil
+		}
+		proposedVal["object"] = prunedObj
+	} else if proposedVal["object"].IsNull() {
 		// plan for Create
 		s.logger.Debug("[PlanResourceChange]", "creating object", dump(completePropMan))
 		newObj, err := tftypes.Transform(completePropMan, func(ap *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
@@ -514,6 +575,22 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 		proposedVal["object"] = updatedObj
 	}
 
+	sensitivePaths, d := sensitiveFields(proposedVal)
+	resp.Diagnostics = append(resp.Diagnostics, d...)
+	if len(d) > 0 {
+		return resp, nil
+	}
+	proposedVal["object"], err = maskSensitiveFields(proposedVal["object"], proposedVal["manifest"], sensitivePaths)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Failed to mask sensitive fields in proposed state",
+			Detail:    err.Error(),
+			Attribute: tftypes.NewAttributePath().WithAttributeName("object"),
+		})
+		return resp, nil
+	}
+
 	propStateVal := tftypes.NewValue(proposedState.Type(), proposedVal)
 	s.logger.Trace("[PlanResourceChange]", "new planned state", dump(propStateVal))
 
@@ -530,3 +607,17 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 	resp.PlannedState = &plannedState
 	return resp, nil
 }
+
+// configurationChanged reports whether the value configured at ap differs
+// between the prior and the proposed manifest.
+func configurationChanged(priorMan, proposedMan tftypes.Value, ap *tftypes.AttributePath) bool {
+	wasCfg, restPath, err := tftypes.WalkAttributePath(priorMan, ap)
+	if err != nil || len(restPath.Steps()) != 0 || !wasCfg.(tftypes.Value).IsKnown() {
+		return true
+	}
+	nowCfg, restPath, err := tftypes.WalkAttributePath(proposedMan, ap)
+	if err != nil || len(restPath.Steps()) != 0 {
+		return true
+	}
+	return !wasCfg.(tftypes.Value).Equal(nowCfg.(tftypes.Value))
+}
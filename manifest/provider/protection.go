@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
+)
+
+// stringListFromValue converts a list of strings from the provider configuration.
+func stringListFromValue(v tftypes.Value) ([]string, error) {
+	if v.IsNull() || !v.IsKnown() {
+		return nil, nil
+	}
+	var vals []tftypes.Value
+	if err := v.As(&vals); err != nil {
+		return nil, err
+	}
+	l := make([]string, 0, len(vals))
+	for _, e := range vals {
+		var s string
+		if err := e.As(&s); err != nil {
+			return nil, err
+		}
+		l = append(l, s)
+	}
+	return l, nil
+}
+
+// checkProtection returns the diagnostic of an operation on a protected
+// object, or of any operation when the provider is read-only. It only looks at
+// the object value, so that it can run before any request is sent to the API server.
+func (s *RawProviderServer) checkProtection(op string, obj tftypes.Value) []*tfprotov5.Diagnostic {
+	if s.protection == nil && !s.readOnly {
+		return nil
+	}
+	kind := stringAttribute(obj, tftypes.NewAttributePath().WithAttributeName("kind"))
+	name := stringAttribute(obj, tftypes.NewAttributePath().WithAttributeName("metadata").WithAttributeName("name"))
+	namespace := stringAttribute(obj, tftypes.NewAttributePath().WithAttributeName("metadata").WithAttributeName("namespace"))
+	if s.readOnly {
+		return []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Provider is read-only",
+			Detail:   util.ReadOnlyError(op, kind, namespace, name).Error(),
+		}}
+	}
+	err := s.protection.Check(op, kind, namespace, name)
+	if err == nil {
+		return nil
+	}
+	severity := tfprotov5.DiagnosticSeverityError
+	if s.protection.Warn() {
+		severity = tfprotov5.DiagnosticSeverityWarning
+	}
+	return []*tfprotov5.Diagnostic{{
+		Severity: severity,
+		Summary:  "Protected object",
+		Detail:   err.Error(),
+	}}
+}
+
+func stringAttribute(v tftypes.Value, path *tftypes.AttributePath) string {
+	if v.Type() == nil || v.IsNull() || !v.IsKnown() {
+		return ""
+	}
+	av, rest, err := tftypes.WalkAttributePath(v, path)
+	if err != nil || len(rest.Steps()) > 0 {
+		return ""
+	}
+	var s string
+	if tv, ok := av.(tftypes.Value); !ok || !tv.Type().Is(tftypes.String) || !tv.IsKnown() || tv.As(&s) != nil {
+		return ""
+	}
+	return s
+}
+
+// priorObjectValue returns the `object` attribute of a resource state.
+func priorObjectValue(state tftypes.Value) tftypes.Value {
+	vals := make(map[string]tftypes.Value)
+	if err := state.As(&vals); err != nil {
+		return tftypes.Value{}
+	}
+	return vals["object"]
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
+)
+
+func TestCheckProtection(t *testing.T) {
+	protection, err := util.NewProtection(nil, []string{"CustomResourceDefinition"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &RawProviderServer{protection: protection}
+	crd := newObjectValue(map[string]tftypes.Value{
+		"apiVersion": tftypes.NewValue(tftypes.String, "apiextensions.k8s.io/v1"),
+		"kind":       tftypes.NewValue(tftypes.String, "CustomResourceDefinition"),
+		"metadata": newObjectValue(map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "widgets.example.com"),
+		}),
+	})
+	diags := s.checkProtection(util.OperationDelete, crd)
+	if len(diags) != 1 || diags[0].Severity != tfprotov5.DiagnosticSeverityError {
+		t.Fatalf("expected an error diagnostic, got %v", diags)
+	}
+
+	cm := newObjectValue(map[string]tftypes.Value{
+		"apiVersion": tftypes.NewValue(tftypes.String, "v1"),
+		"kind":       tftypes.NewValue(tftypes.String, "ConfigMap"),
+		"metadata": newObjectValue(map[string]tftypes.Value{
+			"name":      tftypes.NewValue(tftypes.String, "app"),
+			"namespace": tftypes.NewValue(tftypes.String, "default"),
+		}),
+	})
+	if diags := s.checkProtection(util.OperationDelete, cm); len(diags) != 0 {
+		t.Fatalf("expected no diagnostics, got %v", diags)
+	}
+
+	s.protection.Mode = util.ProtectionModeWarn
+	diags = s.checkProtection(util.OperationCreate, crd)
+	if len(diags) != 1 || diags[0].Severity != tfprotov5.DiagnosticSeverityWarning {
+		t.Fatalf("expected a warning diagnostic, got %v", diags)
+	}
+
+	s = &RawProviderServer{readOnly: true}
+	diags = s.checkProtection(util.OperationUpdate, cm)
+	if len(diags) != 1 || diags[0].Summary != "Provider is read-only" {
+		t.Fatalf("expected a read-only error, got %v", diags)
+	}
+}
@@ -131,6 +131,29 @@ func GetProviderResourceSchema() map[string]*tfprotov5.Schema {
 							},
 						},
 					},
+					{
+						TypeName: "delete_options",
+						Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+						MinItems: 0,
+						MaxItems: 1,
+						Block: &tfprotov5.SchemaBlock{
+							Description: "Options used when deleting the resource. Takes precedence over the provider `delete_options` block.",
+							Attributes: []*tfprotov5.SchemaAttribute{
+								{
+									Name:        "propagation_policy",
+									Type:        tftypes.String,
+									Optional:    true,
+									Description: "Whether and how garbage collection is performed on the dependents of the deleted object. One of `Orphan`, `Background` or `Foreground`.",
+								},
+								{
+									Name:        "grace_period_seconds",
+									Type:        tftypes.Number,
+									Optional:    true,
+									Description: "The duration in seconds before the object should be deleted. Zero means delete immediately.",
+								},
+							},
+						},
+					},
 					{
 						TypeName: "wait",
 						Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
@@ -167,6 +190,12 @@ func GetProviderResourceSchema() map[string]*tfprotov5.Schema {
 									Optional:    true,
 									Description: "Wait for rollout to complete on resources that support `kubectl rollout status`.",
 								},
+								{
+									Name:        "ready",
+									Type:        tftypes.Bool,
+									Optional:    true,
+									Description: "Wait for the resource to be ready, as computed by kstatus: the latest generation is observed, the status of built-in workloads shows the rollout is done, and the `Ready` or `Available` condition of other resources is `True`.",
+								},
 								{
 									Name:        "fields",
 									Type:        tftypes.Map{ElementType: tftypes.String},
@@ -184,6 +213,19 @@ func GetProviderResourceSchema() map[string]*tfprotov5.Schema {
 						Required:    true,
 						Description: "A Kubernetes manifest describing the desired state of the resource in HCL format.",
 					},
+					{
+						Name:        "manifest_wo",
+						Type:        tftypes.DynamicPseudoType,
+						Optional:    true,
+						WriteOnly:   true,
+						Description: "A write-only object merged into `manifest` when the resource is applied. Its values are never stored in the plan or state, nor in `object`.",
+					},
+					{
+						Name:        "manifest_wo_revision",
+						Type:        tftypes.Number,
+						Optional:    true,
+						Description: "The current revision of the write-only `manifest_wo` attribute. Incrementing this value will cause Terraform to update the write-only values.",
+					},
 					{
 						Name:        "object",
 						Type:        tftypes.DynamicPseudoType,
@@ -204,12 +246,30 @@ func GetProviderResourceSchema() map[string]*tfprotov5.Schema {
 						Deprecated:  true,
 						Description: "A map of attribute paths and desired patterns to be matched. After each apply the provider will wait for all attributes listed here to reach a value that matches the desired pattern.",
 					},
+					{
+						Name:        "destroy_behavior",
+						Type:        tftypes.String,
+						Optional:    true,
+						Description: "What to do with the object when the resource is destroyed. `delete` (the default) deletes it, `abandon` leaves it in the cluster and releases the fields and labels managed by Terraform.",
+					},
 					{
 						Name:        "computed_fields",
 						Type:        tftypes.List{ElementType: tftypes.String},
 						Description: "List of manifest fields whose values can be altered by the API server during 'apply'. Defaults to: [\"metadata.annotations\", \"metadata.labels\"]",
 						Optional:    true,
 					},
+					{
+						Name:        "managed_fields_only",
+						Type:        tftypes.Bool,
+						Optional:    true,
+						Description: "When true, `object` only holds the fields set in `manifest` that are owned by the field manager, instead of the whole object returned by the API server. This reduces the size of the state for large objects.",
+					},
+					{
+						Name:        "sensitive_fields",
+						Type:        tftypes.List{ElementType: tftypes.String},
+						Description: "List of fields whose values are stored in `object` as a placeholder instead of in plain text, like `data` and `stringData` for a Secret.",
+						Optional:    true,
+					},
 				},
 			},
 		},
@@ -200,6 +200,105 @@ func GetProviderConfigSchema() *tfprotov5.Schema {
 				DescriptionKind: 0,
 				Deprecated:      false,
 			},
+			{
+				Name:            "namespace",
+				Type:            tftypes.String,
+				Description:     "Namespace used by namespaced resources and data sources that do not set one. Defaults to the namespace of the selected kubeconfig context, or `default`.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "default_labels",
+				Type:            tftypes.Map{ElementType: tftypes.String},
+				Description:     "Labels added to the metadata of every resource handled by this provider. Labels set on a resource take precedence.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "default_annotations",
+				Type:            tftypes.Map{ElementType: tftypes.String},
+				Description:     "Annotations added to the metadata of every resource handled by this provider. Annotations set on a resource take precedence.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "startup_timeout",
+				Type:            tftypes.String,
+				Description:     "Maximum time to wait for the Kubernetes API server to report ready on its `/readyz` endpoint when the provider is configured, e.g. `5m`. Useful when the cluster is created in the same run. By default the provider does not wait.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "read_only",
+				Type:            tftypes.Bool,
+				Description:     "Refuse every create, update and delete operation before it reaches the API server. Reads, data sources and plan-time dry-runs keep working.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "protected_namespaces",
+				Type:            tftypes.List{ElementType: tftypes.String},
+				Description:     "List of namespaces in which objects must not be created, updated or deleted, e.g. `kube-system`. Each item is a namespace name or a glob pattern.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "protected_kinds",
+				Type:            tftypes.List{ElementType: tftypes.String},
+				Description:     "List of object kinds that must not be created, updated or deleted, e.g. `CustomResourceDefinition`.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "protection_mode",
+				Type:            tftypes.String,
+				Description:     "What to do with operations on objects matched by `protected_namespaces` or `protected_kinds`: `deny` refuses them, `warn` only warns about them. Defaults to `deny`.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "server_dry_run",
+				Type:            tftypes.Bool,
+				Description:     "Validate typed resources with a server-side dry-run of the planned object when planning, so that objects rejected by the API server or by admission webhooks fail the plan.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
 		},
 		BlockTypes: []*tfprotov5.SchemaNestedBlock{
 			{
@@ -252,6 +351,72 @@ func GetProviderConfigSchema() *tfprotov5.Schema {
 					},
 				},
 			},
+			{
+				TypeName: "delete_options",
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+				MinItems: 0,
+				MaxItems: 1,
+				Block: &tfprotov5.SchemaBlock{
+					Description: "Default options used when deleting resources. Can be overridden with the `delete_options` block of each resource.",
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:            "propagation_policy",
+							Type:            tftypes.String,
+							Required:        false,
+							Optional:        true,
+							Computed:        false,
+							Sensitive:       false,
+							Description:     "Whether and how garbage collection is performed on the dependents of the deleted object. One of `Orphan`, `Background` or `Foreground`.",
+							DescriptionKind: 0,
+							Deprecated:      false,
+						},
+						{
+							Name:            "grace_period_seconds",
+							Type:            tftypes.String,
+							Required:        false,
+							Optional:        true,
+							Computed:        false,
+							Sensitive:       false,
+							Description:     "The duration in seconds before the object should be deleted. Zero means delete immediately.",
+							DescriptionKind: 0,
+							Deprecated:      false,
+						},
+					},
+				},
+			},
+			{
+				TypeName: "server_side_apply",
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+				MinItems: 0,
+				MaxItems: 1,
+				Block: &tfprotov5.SchemaBlock{
+					Description: "Enable server-side apply for the typed resources that support it. Objects are applied with an apply patch instead of being created and updated.",
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:            "field_manager",
+							Type:            tftypes.String,
+							Required:        false,
+							Optional:        true,
+							Computed:        false,
+							Sensitive:       false,
+							Description:     "The name of the field manager used to apply resources. Defaults to `Terraform`.",
+							DescriptionKind: 0,
+							Deprecated:      false,
+						},
+						{
+							Name:            "force_conflicts",
+							Type:            tftypes.Bool,
+							Required:        false,
+							Optional:        true,
+							Computed:        false,
+							Sensitive:       false,
+							Description:     "Force changes against conflicts with other field managers.",
+							DescriptionKind: 0,
+							Deprecated:      false,
+						},
+					},
+				},
+			},
 			{
 				TypeName: "experiments",
 				Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest/morph"
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest/payload"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -20,6 +21,9 @@ import (
 func (s *RawProviderServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
 	resp := &tfprotov5.ReadResourceResponse{}
 
+	ctx, wc := util.ContextWithWarningCollector(ctx)
+	defer func() { resp.Diagnostics = append(resp.Diagnostics, APIWarningsToDiagnostics(wc)...) }()
+
 	cp := req.ClientCapabilities
 	if cp != nil && cp.DeferralAllowed && s.clientConfigUnknown {
 		// if client support it, request deferral when client configuration not fully known
@@ -170,23 +174,56 @@ func (s *RawProviderServer) ReadResource(ctx context.Context, req *tfprotov5.Rea
 		return resp, nil
 	}
 
-	fo := RemoveServerSideFields(ro.Object)
-	nobj, err := payload.ToTFValue(fo, objectType, th, tftypes.NewAttributePath())
+	var nobj tftypes.Value
+	if managedFieldsOnly(resState) {
+		fieldManagerName, _, err := s.getFieldManagerConfig(resState)
+		if err != nil {
+			return resp, err
+		}
+		nobj, err = managedObjectValue(ro, fieldManagerName, objectType, th, co.Type())
+		if err != nil {
+			return resp, err
+		}
+	} else {
+		fo := RemoveServerSideFields(ro.Object)
+		nobj, err = payload.ToTFValue(fo, objectType, th, tftypes.NewAttributePath())
+		if err != nil {
+			return resp, err
+		}
+
+		nobj, err = morph.DeepUnknown(objectType, nobj, tftypes.NewAttributePath())
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	rawState := make(map[string]tftypes.Value)
+	err = currentState.As(&rawState)
 	if err != nil {
 		return resp, err
 	}
-
-	nobj, err = morph.DeepUnknown(objectType, nobj, tftypes.NewAttributePath())
+	sensitivePaths, d := sensitiveFields(rawState)
+	resp.Diagnostics = append(resp.Diagnostics, d...)
+	if len(d) > 0 {
+		return resp, nil
+	}
+	woFields, err := writeOnlyFieldsFromPrivate(req.Private)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Unexpected format for private state",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	nobj, err = removeWriteOnlyFields(morph.UnknownToNull(nobj), woFields)
 	if err != nil {
 		return resp, err
 	}
-
-	rawState := make(map[string]tftypes.Value)
-	err = currentState.As(&rawState)
+	rawState["object"], err = maskSensitiveFields(nobj, rawState["manifest"], sensitivePaths)
 	if err != nil {
 		return resp, err
 	}
-	rawState["object"] = morph.UnknownToNull(nobj)
 
 	nsVal := tftypes.NewValue(currentState.Type(), rawState)
 	newState, err := tfprotov5.NewDynamicValue(nsVal.Type(), nsVal)
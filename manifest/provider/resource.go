@@ -194,8 +194,8 @@ func RemoveServerSideFields(in map[string]interface{}) map[string]interface{} {
 	delete(meta, "generation")
 	delete(meta, "selfLink")
 
-	// TODO: we should be filtering API responses based on the contents of 'managedFields'
-	// and only retain the attributes for which the manager is Terraform
+	// With 'managed_fields_only', API responses are filtered based on the contents
+	// of 'managedFields' before this point, see managedObjectValue
 	delete(meta, "managedFields")
 
 	return in
@@ -283,6 +283,13 @@ func (ps *RawProviderServer) fetchCRDs(ctx context.Context) ([]unstructured.Unst
 // privateStateSchema describes the structure of the private state payload that
 // Terraform can store along with the "regular" resource state state.
 var privateStateSchema tftypes.Object = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"IsImported":      tftypes.Bool,
+	"WriteOnlyFields": tftypes.List{ElementType: tftypes.String},
+}}
+
+// legacyPrivateStateSchema is the structure of the private state stored by
+// earlier versions of the provider.
+var legacyPrivateStateSchema tftypes.Object = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 	"IsImported": tftypes.Bool,
 }}
 
@@ -293,6 +300,14 @@ func getPrivateStateValue(p []byte) (ps map[string]tftypes.Value, err error) {
 	}
 	pv, err := tftypes.ValueFromMsgPack(p, privateStateSchema)
 	if err != nil {
+		lv, lerr := tftypes.ValueFromMsgPack(p, legacyPrivateStateSchema)
+		if lerr != nil {
+			return
+		}
+		if err = lv.As(&ps); err != nil {
+			return
+		}
+		ps["WriteOnlyFields"] = tftypes.NewValue(privateStateSchema.AttributeTypes["WriteOnlyFields"], nil)
 		return
 	}
 	err = pv.As(&ps)
@@ -37,8 +37,8 @@ func (s *RawProviderServer) UpgradeResourceIdentity(ctx context.Context, req *tf
 	return resp, nil
 }
 
-func parseResourceIdentityData(rid *tfprotov5.ResourceIdentityData) (schema.GroupVersionKind, string, string, error) {
-	namespace := "default"
+func parseResourceIdentityData(rid *tfprotov5.ResourceIdentityData, defaultNamespace string) (schema.GroupVersionKind, string, string, error) {
+	namespace := defaultNamespace
 	var apiVersion, kind, name string
 
 	iddata, err := rid.IdentityData.Unmarshal(getIdentityType())
@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-kubernetes/manifest/morph"
+)
+
+const (
+	// sensitiveValuePrefix starts the placeholders stored in `object` in place
+	// of a sensitive value.
+	sensitiveValuePrefix = "(sensitive value"
+	// sensitiveValuePlaceholder replaces a sensitive value.
+	sensitiveValuePlaceholder = "(sensitive value)"
+	// sensitiveValueChangedPlaceholder replaces a sensitive value that differs
+	// from the value configured in `manifest`.
+	sensitiveValueChangedPlaceholder = "(sensitive value changed outside of Terraform)"
+)
+
+// sensitiveFields returns the paths in `object` whose values must not be
+// stored in plain text, as listed in the `sensitive_fields` of the resource.
+func sensitiveFields(vals map[string]tftypes.Value) ([]*tftypes.AttributePath, []*tfprotov5.Diagnostic) {
+	var paths []*tftypes.AttributePath
+	var diags []*tfprotov5.Diagnostic
+	sf, ok := vals["sensitive_fields"]
+	if !ok || sf.IsNull() {
+		return nil, nil
+	}
+	fields, err := stringListFromValue(sf)
+	if err != nil {
+		return nil, []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "[sensitive_fields] cannot extract elements from list",
+			Detail:   err.Error(),
+		}}
+	}
+	for _, f := range fields {
+		atp, err := FieldPathToTftypesPath(f)
+		if err != nil {
+			diags = append(diags, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "[sensitive_fields] cannot parse field path element: " + f,
+				Detail:   err.Error(),
+			})
+			continue
+		}
+		paths = append(paths, atp)
+	}
+	return paths, diags
+}
+
+// isSensitivePath reports whether ap is one of paths or is nested under one of them.
+func isSensitivePath(ap *tftypes.AttributePath, paths []*tftypes.AttributePath) bool {
+	steps := ap.Steps()
+	for _, p := range paths {
+		if len(steps) >= len(p.Steps()) && stepsMatch(steps[:len(p.Steps())], p.Steps()) {
+			return true
+		}
+	}
+	return false
+}
+
+// stepsMatch compares attribute paths without telling the fields of objects
+// from the keys of maps apart: `manifest` is an object where `object` may
+// have a map for the same field.
+func stepsMatch(a, b []tftypes.AttributePathStep) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		an, aok := stepKey(a[i])
+		bn, bok := stepKey(b[i])
+		if aok != bok || (aok && an != bn) || (!aok && !a[i].Equal(b[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+func stepKey(step tftypes.AttributePathStep) (string, bool) {
+	switch s := step.(type) {
+	case tftypes.AttributeName:
+		return string(s), true
+	case tftypes.ElementKeyString:
+		return string(s), true
+	}
+	return "", false
+}
+
+// isSecret reports whether obj is a core Secret.
+func isSecret(obj tftypes.Value) bool {
+	return stringAttribute(obj, tftypes.NewAttributePath().WithAttributeName("apiVersion")) == "v1" &&
+		stringAttribute(obj, tftypes.NewAttributePath().WithAttributeName("kind")) == "Secret"
+}
+
+// walkValuePath returns the value at ap in v, addressing the fields of
+// objects and the keys of maps alike.
+func walkValuePath(v tftypes.Value, ap *tftypes.AttributePath) (tftypes.Value, bool) {
+	for _, step := range ap.Steps() {
+		if key, ok := stepKey(step); ok {
+			if v.Type() != nil && v.Type().Is(tftypes.Object{}) {
+				step = tftypes.AttributeName(key)
+			} else {
+				step = tftypes.ElementKeyString(key)
+			}
+		}
+		next, err := v.ApplyTerraform5AttributePathStep(step)
+		if err != nil {
+			return tftypes.Value{}, false
+		}
+		nv, ok := next.(tftypes.Value)
+		if !ok {
+			return tftypes.Value{}, false
+		}
+		v = nv
+	}
+	return v, true
+}
+
+// maskSensitiveValue returns the placeholder of the sensitive value s at ap.
+// Values are not hashed, since the hash of a short or guessable value can be
+// reversed offline: a change of the value outside of Terraform is detected by
+// comparing it with the value configured in manifest instead.
+func maskSensitiveValue(s string, manifest tftypes.Value, ap *tftypes.AttributePath) string {
+	if strings.HasPrefix(s, sensitiveValuePrefix) {
+		return s
+	}
+	if configured, ok := configuredString(manifest, ap); ok && configured != s {
+		return sensitiveValueChangedPlaceholder
+	}
+	return sensitiveValuePlaceholder
+}
+
+// configuredString returns the string configured at ap in manifest, if any.
+// The API server merges the `stringData` of a Secret into its `data`, so a
+// key of `data` is compared with the base64 encoding of the same key of
+// `stringData` when it is set.
+func configuredString(manifest tftypes.Value, ap *tftypes.AttributePath) (string, bool) {
+	if manifest.Type() == nil || !manifest.IsKnown() || manifest.IsNull() {
+		return "", false
+	}
+	if steps := ap.Steps(); len(steps) == 2 && isSecret(manifest) {
+		field, _ := stepKey(steps[0])
+		if key, ok := stepKey(steps[1]); ok && field == "data" {
+			sd := tftypes.NewAttributePath().WithAttributeName("stringData").WithElementKeyString(key)
+			if s, ok := configuredString(manifest, sd); ok {
+				return base64.StdEncoding.EncodeToString([]byte(s)), true
+			}
+		}
+	}
+	v, ok := walkValuePath(manifest, ap)
+	if !ok || !v.IsKnown() || v.IsNull() || !v.Type().Is(tftypes.String) {
+		return "", false
+	}
+	var s string
+	if err := v.As(&s); err != nil {
+		return "", false
+	}
+	return s, true
+}
+
+// maskSensitiveFields replaces the known string values at the sensitive paths
+// of obj with a placeholder, which tells the values that differ from the ones
+// configured in manifest apart.
+func maskSensitiveFields(obj, manifest tftypes.Value, paths []*tftypes.AttributePath) (tftypes.Value, error) {
+	if len(paths) == 0 || obj.Type() == nil || obj.IsNull() {
+		return obj, nil
+	}
+	return tftypes.Transform(obj, func(ap *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.Type().Is(tftypes.String) || !v.IsKnown() || v.IsNull() || !isSensitivePath(ap, paths) {
+			return v, nil
+		}
+		var s string
+		if err := v.As(&s); err != nil {
+			return v, ap.NewError(err)
+		}
+		return tftypes.NewValue(tftypes.String, maskSensitiveValue(s, manifest, ap)), nil
+	})
+}
+
+// unmaskSensitiveFields puts back the values configured in manifest in place
+// of the placeholders at the sensitive paths of obj, before it is sent to the
+// API server. Placeholders of values that are not in manifest are removed.
+func unmaskSensitiveFields(obj, manifest tftypes.Value, paths []*tftypes.AttributePath) (tftypes.Value, error) {
+	if len(paths) == 0 {
+		return obj, nil
+	}
+	return tftypes.Transform(obj, func(ap *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.Type().Is(tftypes.String) || !v.IsKnown() || v.IsNull() || !isSensitivePath(ap, paths) {
+			return v, nil
+		}
+		var s string
+		if err := v.As(&s); err != nil {
+			return v, ap.NewError(err)
+		}
+		if !strings.HasPrefix(s, sensitiveValuePrefix) {
+			return v, nil
+		}
+		mv, ok := walkValuePath(manifest, ap)
+		if !ok {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		nv, d := morph.ValueToType(mv, v.Type(), tftypes.NewAttributePath())
+		if len(d) > 0 {
+			return v, ap.NewErrorf("cannot restore the sensitive value from manifest")
+		}
+		return nv, nil
+	})
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMaskSensitiveFields(t *testing.T) {
+	secret := func(password string) tftypes.Value {
+		return newObjectValue(map[string]tftypes.Value{
+			"apiVersion": tftypes.NewValue(tftypes.String, "v1"),
+			"kind":       tftypes.NewValue(tftypes.String, "Secret"),
+			"metadata": newObjectValue(map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "app"),
+			}),
+			"data": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"password": tftypes.NewValue(tftypes.String, password),
+			}),
+			"type": tftypes.NewValue(tftypes.String, "Opaque"),
+		})
+	}
+	passwordPath := tftypes.NewAttributePath().WithAttributeName("data").WithElementKeyString("password")
+	typePath := tftypes.NewAttributePath().WithAttributeName("type")
+
+	obj := secret("c2VjcmV0")
+	paths, diags := sensitiveFields(map[string]tftypes.Value{
+		"sensitive_fields": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "data"),
+			tftypes.NewValue(tftypes.String, "type"),
+		}),
+	})
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	// the configured values are sent to the API server
+	manifest := newObjectValue(map[string]tftypes.Value{
+		"data": newObjectValue(map[string]tftypes.Value{
+			"password": tftypes.NewValue(tftypes.String, "c2VjcmV0"),
+		}),
+	})
+	masked, err := maskSensitiveFields(obj, manifest, paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := stringAttribute(masked, passwordPath); v != sensitiveValuePlaceholder {
+		t.Errorf("expected the Secret data to be masked, got %q", v)
+	}
+	if v := stringAttribute(masked, typePath); v != sensitiveValuePlaceholder {
+		t.Errorf("expected the sensitive field to be masked, got %q", v)
+	}
+	if v := stringAttribute(masked, tftypes.NewAttributePath().WithAttributeName("metadata").WithAttributeName("name")); v != "app" {
+		t.Errorf("expected the name to be kept, got %q", v)
+	}
+
+	// masking is stable, and the placeholder does not depend on the value
+	again, err := maskSensitiveFields(masked, manifest, paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !again.Equal(masked) {
+		t.Error("expected masking a masked value to keep it")
+	}
+	other, err := maskSensitiveFields(secret("b3RoZXI="), tftypes.Value{}, paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := stringAttribute(other, passwordPath); v != sensitiveValuePlaceholder {
+		t.Errorf("expected a value without configuration to have the same placeholder, got %q", v)
+	}
+
+	// a value changed outside of Terraform differs from the configured one
+	changed, err := maskSensitiveFields(secret("b3RoZXI="), manifest, paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := stringAttribute(changed, passwordPath); v != sensitiveValueChangedPlaceholder {
+		t.Errorf("expected the changed value to be detected, got %q", v)
+	}
+
+	unmasked, err := unmaskSensitiveFields(masked, manifest, paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := stringAttribute(unmasked, passwordPath); v != "c2VjcmV0" {
+		t.Errorf("expected the configured value to be restored, got %q", v)
+	}
+	if v, _, _ := tftypes.WalkAttributePath(unmasked, typePath); !v.(tftypes.Value).IsNull() {
+		t.Errorf("expected an unconfigured sensitive value to be removed, got %v", v)
+	}
+
+	// the stringData of a Secret is compared with its data once encoded
+	stringData := newObjectValue(map[string]tftypes.Value{
+		"apiVersion": tftypes.NewValue(tftypes.String, "v1"),
+		"kind":       tftypes.NewValue(tftypes.String, "Secret"),
+		"stringData": newObjectValue(map[string]tftypes.Value{
+			"password": tftypes.NewValue(tftypes.String, "secret"),
+		}),
+	})
+	fromStringData, err := maskSensitiveFields(obj, stringData, paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := stringAttribute(fromStringData, passwordPath); v != sensitiveValuePlaceholder {
+		t.Errorf("expected the data set from stringData to be unchanged, got %q", v)
+	}
+
+	// Secrets are only masked when their fields are listed
+	if paths, _ := sensitiveFields(map[string]tftypes.Value{}); len(paths) != 0 {
+		t.Errorf("expected no sensitive fields without sensitive_fields, got %v", paths)
+	}
+}
@@ -9,13 +9,16 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest/openapi"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/install"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 )
@@ -42,10 +45,23 @@ type RawProviderServer struct {
 	discoveryClient             cache[discovery.DiscoveryInterface]
 	restMapper                  cache[meta.RESTMapper]
 	restClient                  cache[rest.Interface]
+	clientset                   cache[kubernetes.Interface]
 	OAPIFoundry                 cache[openapi.Foundry]
 	crds                        cache[[]unstructured.Unstructured]
 	checkValidCredentialsResult cache[[]*tfprotov5.Diagnostic]
 
+	// deleteOptions holds the provider level defaults for deleting resources
+	deleteOptions metav1.DeleteOptions
+	// readOnly refuses every operation that writes to the cluster
+	readOnly bool
+	// protection refuses or warns about operations on protected objects
+	protection *util.Protection
+	// namespace is used for namespaced objects that do not set one
+	namespace string
+	// defaultLabels and defaultAnnotations are added to the metadata of every object
+	defaultLabels      map[string]string
+	defaultAnnotations map[string]string
+
 	hostTFVersion string
 }
 
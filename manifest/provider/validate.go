@@ -11,6 +11,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // ValidateResourceTypeConfig function
@@ -54,6 +56,9 @@ func (s *RawProviderServer) ValidateResourceTypeConfig(ctx context.Context, req
 		return resp, nil
 	}
 
+	writeOnlyAllowed := req.ClientCapabilities != nil && req.ClientCapabilities.WriteOnlyAttributesAllowed
+	resp.Diagnostics = append(resp.Diagnostics, validateWriteOnlyManifest(configVal, writeOnlyAllowed)...)
+
 	manifest, ok := configVal["manifest"]
 	if !ok {
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
@@ -121,6 +126,26 @@ func (s *RawProviderServer) ValidateResourceTypeConfig(ctx context.Context, req
 		}
 	}
 
+	// validate delete_options block
+	if err := mergeDeleteOptionsBlock(&metav1.DeleteOptions{}, configVal["delete_options"]); err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Invalid delete_options",
+			Detail:    err.Error(),
+			Attribute: tftypes.NewAttributePath().WithAttributeName("delete_options"),
+		})
+	}
+
+	// validate destroy_behavior attribute
+	if db := getDestroyBehavior(configVal); db != util.DestroyBehaviorDelete && db != util.DestroyBehaviorAbandon {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Invalid destroy_behavior",
+			Detail:    fmt.Sprintf("%q is not a valid destroy_behavior, must be one of %q or %q.", db, util.DestroyBehaviorDelete, util.DestroyBehaviorAbandon),
+			Attribute: tftypes.NewAttributePath().WithAttributeName("destroy_behavior"),
+		})
+	}
+
 	// validate wait block
 	if wait, ok := configVal["wait"]; ok && !wait.IsNull() {
 		var waitBlock []tftypes.Value
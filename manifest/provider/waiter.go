@@ -17,9 +17,12 @@ import (
 
 	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-provider-kubernetes/util"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/kubectl/pkg/polymorphichelpers"
 )
 
@@ -30,7 +33,12 @@ func (s *RawProviderServer) waitForCompletion(ctx context.Context, waitForBlock
 		return nil
 	}
 
-	waiter, err := NewResourceWaiter(rs, rname, rtype, th, waitForBlock, s.logger)
+	// the typed client is only used to describe failures
+	clientset, err := s.getClientset()
+	if err != nil {
+		s.logger.Debug("[ApplyResourceChange][Wait] Failures will not be described", "error", err)
+	}
+	waiter, err := NewResourceWaiter(rs, rname, rtype, th, waitForBlock, clientset, s.logger)
 	if err != nil {
 		return err
 	}
@@ -44,14 +52,26 @@ type Waiter interface {
 
 type WaiterError struct {
 	Reason string
+	// Details describes why the resource is not ready yet, when known
+	Details string
 }
 
 func (e WaiterError) Error() string {
-	return fmt.Sprintf("timed out waiting on %v", e.Reason)
+	return fmt.Sprintf("timed out waiting on %v%s", e.Reason, e.Details)
+}
+
+// ReadinessError is returned when the status of a resource shows it cannot
+// become ready, like a failed Job or a stalled custom resource
+type ReadinessError struct {
+	Message string
+}
+
+func (e ReadinessError) Error() string {
+	return fmt.Sprintf("resource failed to become ready: %s", e.Message)
 }
 
 // NewResourceWaiter constructs an appropriate Waiter using the supplied waitForBlock configuration
-func NewResourceWaiter(resource dynamic.ResourceInterface, resourceName string, resourceType tftypes.Type, th map[string]string, waitForBlock tftypes.Value, hl hclog.Logger) (Waiter, error) {
+func NewResourceWaiter(resource dynamic.ResourceInterface, resourceName string, resourceType tftypes.Type, th map[string]string, waitForBlock tftypes.Value, clientset kubernetes.Interface, hl hclog.Logger) (Waiter, error) {
 	var waitForBlockVal map[string]tftypes.Value
 	err := waitForBlock.As(&waitForBlockVal)
 	if err != nil {
@@ -63,6 +83,19 @@ func NewResourceWaiter(resource dynamic.ResourceInterface, resourceName string,
 		v.As(&rollout)
 		if rollout {
 			return &RolloutWaiter{
+				resource,
+				resourceName,
+				clientset,
+				hl,
+			}, nil
+		}
+	}
+
+	if v, ok := waitForBlockVal["ready"]; ok {
+		var ready bool
+		v.As(&ready)
+		if ready {
+			return &ReadyWaiter{
 				resource,
 				resourceName,
 				hl,
@@ -282,20 +315,23 @@ func FieldPathToTftypesPath(fieldPath string) (*tftypes.AttributePath, error) {
 type RolloutWaiter struct {
 	resource     dynamic.ResourceInterface
 	resourceName string
+	clientset    kubernetes.Interface
 	logger       hclog.Logger
 }
 
 // Wait uses StatusViewer to determine if the rollout is done
 func (w *RolloutWaiter) Wait(ctx context.Context) error {
 	w.logger.Info("[ApplyResourceChange][Wait] Waiting until rollout complete...\n")
+	var res *unstructured.Unstructured
 	for {
 		if deadline, ok := ctx.Deadline(); ok {
 			if time.Now().After(deadline) {
-				return WaiterError{Reason: "rollout to complete"}
+				return WaiterError{Reason: "rollout to complete", Details: w.describeFailure(ctx, res)}
 			}
 		}
 
-		res, err := w.resource.Get(ctx, w.resourceName, v1.GetOptions{})
+		var err error
+		res, err = w.resource.Get(ctx, w.resourceName, v1.GetOptions{})
 		if err != nil {
 			return err
 		}
@@ -325,6 +361,77 @@ func (w *RolloutWaiter) Wait(ctx context.Context) error {
 	return nil
 }
 
+// describeFailure returns the state and last log lines of the failing
+// containers of the pods of res, the last version of the resource read.
+func (w *RolloutWaiter) describeFailure(ctx context.Context, res *unstructured.Unstructured) string {
+	if w.clientset == nil || res == nil {
+		return ""
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), describeTimeout)
+	defer cancel()
+
+	pods, _, err := ownedPods(ctx, w.clientset, res)
+	if err != nil {
+		w.logger.Debug("[ApplyResourceChange][Wait] Could not list the pods of the resource", "error", err)
+		return ""
+	}
+	if failures := util.DescribeContainerFailures(ctx, w.clientset, pods, util.FailureContainersLimit, util.FailureLogLines); failures != "" {
+		return "\n\nFailing containers:" + failures
+	}
+	return ""
+}
+
+// ReadyWaiter will wait for a resource to be ready, as computed by kstatus
+type ReadyWaiter struct {
+	resource     dynamic.ResourceInterface
+	resourceName string
+	logger       hclog.Logger
+}
+
+// Wait polls the resource until it is ready, and fails early when its status
+// shows it cannot become ready
+func (w *ReadyWaiter) Wait(ctx context.Context) error {
+	w.logger.Info("[ApplyResourceChange][Wait] Waiting until ready...\n")
+	var readiness util.Readiness
+	for {
+		if deadline, ok := ctx.Deadline(); ok {
+			if time.Now().After(deadline) {
+				return WaiterError{Reason: "resource to be ready", Details: readinessDetails(readiness)}
+			}
+		}
+
+		res, err := w.resource.Get(ctx, w.resourceName, v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if errors.IsGone(err) {
+			return fmt.Errorf("resource was deleted")
+		}
+
+		readiness = util.ComputeReadiness(res)
+		w.logger.Trace("[ApplyResourceChange][Wait]", "status", readiness.Status, "message", readiness.Message)
+		if readiness.Status == util.ReadinessCurrent {
+			break
+		}
+		if readiness.Status == util.ReadinessFailed {
+			return ReadinessError{Message: readiness.Message}
+		}
+
+		time.Sleep(waiterSleepTime) // lintignore:R018
+	}
+
+	w.logger.Info("[ApplyResourceChange][Wait] Resource is ready\n")
+	return nil
+}
+
+// readinessDetails describes why a resource is not ready, when known
+func readinessDetails(r util.Readiness) string {
+	if r.Message == "" {
+		return ""
+	}
+	return ": " + r.Message
+}
+
 // ConditionsWaiter will wait for the specified conditions on
 // the resource to be met
 type ConditionsWaiter struct {
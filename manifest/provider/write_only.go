@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// writeOnlyFields returns the field paths of the values set in `manifest_wo`,
+// e.g. `spec["password"]`. Objects and maps are walked down to their values.
+func writeOnlyFields(v tftypes.Value) []string {
+	if v.Type() == nil || v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+	var fields []string
+	var walk func(path string, v tftypes.Value)
+	walk = func(path string, v tftypes.Value) {
+		if v.IsNull() {
+			return
+		}
+		if v.IsKnown() && (v.Type().Is(tftypes.Object{}) || v.Type().Is(tftypes.Map{})) {
+			var atts map[string]tftypes.Value
+			if err := v.As(&atts); err == nil {
+				for k, av := range atts {
+					if path == "" {
+						walk(k, av)
+					} else {
+						walk(fmt.Sprintf("%s[%s]", path, strconv.Quote(k)), av)
+					}
+				}
+				return
+			}
+		}
+		fields = append(fields, path)
+	}
+	walk("", v)
+	sort.Strings(fields)
+	return fields
+}
+
+func writeOnlyFieldPaths(fields []string) ([]*tftypes.AttributePath, error) {
+	paths := make([]*tftypes.AttributePath, 0, len(fields))
+	for _, f := range fields {
+		atp, err := FieldPathToTftypesPath(f)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, atp)
+	}
+	return paths, nil
+}
+
+// removeWriteOnlyFields removes the values set from `manifest_wo` from obj, so
+// that they are never stored in `object`.
+func removeWriteOnlyFields(obj tftypes.Value, fields []string) (tftypes.Value, error) {
+	if len(fields) == 0 || obj.Type() == nil || obj.IsNull() {
+		return obj, nil
+	}
+	paths, err := writeOnlyFieldPaths(fields)
+	if err != nil {
+		return obj, err
+	}
+	return tftypes.Transform(obj, func(ap *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if len(ap.Steps()) > 0 && isSensitivePath(ap, paths) {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		if !v.Type().Is(tftypes.Map{}) || v.IsNull() || !v.IsKnown() {
+			return v, nil
+		}
+		// map keys only set in `manifest_wo` are left out altogether
+		var elems map[string]tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return v, ap.NewError(err)
+		}
+		kept := make(map[string]tftypes.Value, len(elems))
+		for k, e := range elems {
+			if !isSensitivePath(ap.WithElementKeyString(k), paths) {
+				kept[k] = e
+			}
+		}
+		return tftypes.NewValue(v.Type(), kept), nil
+	})
+}
+
+// mergeWriteOnlyPayload merges the values of `manifest_wo` into the payload
+// sent to the API server.
+func mergeWriteOnlyPayload(dst, src map[string]interface{}) {
+	for k, sv := range src {
+		sm, sok := sv.(map[string]interface{})
+		dm, dok := dst[k].(map[string]interface{})
+		if sok && dok {
+			mergeWriteOnlyPayload(dm, sm)
+			continue
+		}
+		dst[k] = sv
+	}
+}
+
+// validateWriteOnlyManifest checks the `manifest_wo` and `manifest_wo_revision`
+// attributes of a resource configuration.
+func validateWriteOnlyManifest(configVal map[string]tftypes.Value, writeOnlyAllowed bool) []*tfprotov5.Diagnostic {
+	var diags []*tfprotov5.Diagnostic
+	wo, ok := configVal["manifest_wo"]
+	if !ok {
+		return nil
+	}
+	att := tftypes.NewAttributePath().WithAttributeName("manifest_wo")
+	if rev, ok := configVal["manifest_wo_revision"]; ok && !rev.IsNull() && wo.IsNull() {
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Missing write-only manifest",
+			Detail:    "\"manifest_wo_revision\" can only be set together with \"manifest_wo\".",
+			Attribute: tftypes.NewAttributePath().WithAttributeName("manifest_wo_revision"),
+		})
+	}
+	if wo.IsNull() {
+		return diags
+	}
+	if !writeOnlyAllowed {
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Write-only attribute not allowed",
+			Detail:    "\"manifest_wo\" is a write-only attribute, which requires Terraform 1.11 or later.",
+			Attribute: att,
+		})
+		return diags
+	}
+	if !wo.IsKnown() {
+		return diags
+	}
+	if !wo.Type().Is(tftypes.Object{}) && !wo.Type().Is(tftypes.Map{}) {
+		return append(diags, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Invalid write-only manifest",
+			Detail:    "\"manifest_wo\" must be an object with the fields to merge into the manifest.",
+			Attribute: att,
+		})
+	}
+	var atts map[string]tftypes.Value
+	if err := wo.As(&atts); err != nil {
+		return diags
+	}
+	for _, k := range []string{"apiVersion", "kind", "metadata"} {
+		if _, ok := atts[k]; ok {
+			diags = append(diags, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Invalid write-only manifest",
+				Detail:    fmt.Sprintf("%q cannot be set in \"manifest_wo\", set it in \"manifest\" instead.", k),
+				Attribute: att.WithAttributeName(k),
+			})
+		}
+	}
+	return diags
+}
+
+// writeOnlyFieldsFromPrivate returns the fields set from `manifest_wo` when
+// the resource was last applied.
+func writeOnlyFieldsFromPrivate(p []byte) ([]string, error) {
+	if len(p) == 0 {
+		return nil, nil
+	}
+	ps, err := getPrivateStateValue(p)
+	if err != nil {
+		return nil, err
+	}
+	return stringListFromValue(ps["WriteOnlyFields"])
+}
+
+// privateStateWithWriteOnlyFields returns the private state recording the
+// fields set from `manifest_wo`, or nil when there are none.
+func privateStateWithWriteOnlyFields(fields []string) ([]byte, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	l := make([]tftypes.Value, 0, len(fields))
+	for _, f := range fields {
+		l = append(l, tftypes.NewValue(tftypes.String, f))
+	}
+	pv := tftypes.NewValue(privateStateSchema, map[string]tftypes.Value{
+		"IsImported":      tftypes.NewValue(tftypes.Bool, false),
+		"WriteOnlyFields": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, l),
+	})
+	return pv.MarshalMsgPack(privateStateSchema)
+}
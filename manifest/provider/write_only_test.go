@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWriteOnlyFields(t *testing.T) {
+	wo := newObjectValue(map[string]tftypes.Value{
+		"spec": newObjectValue(map[string]tftypes.Value{
+			"password": tftypes.NewValue(tftypes.String, "hunter2"),
+			"credentials": newObjectValue(map[string]tftypes.Value{
+				"api.key": tftypes.NewValue(tftypes.String, "abc"),
+			}),
+		}),
+	})
+	fields := writeOnlyFields(wo)
+	expected := []string{`spec["credentials"]["api.key"]`, `spec["password"]`}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Fatalf("expected %v got %v", expected, fields)
+	}
+
+	obj := newObjectValue(map[string]tftypes.Value{
+		"kind": tftypes.NewValue(tftypes.String, "Database"),
+		"spec": newObjectValue(map[string]tftypes.Value{
+			"user":     tftypes.NewValue(tftypes.String, "admin"),
+			"password": tftypes.NewValue(tftypes.String, "hunter2"),
+			"credentials": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"api.key": tftypes.NewValue(tftypes.String, "abc"),
+				"region":  tftypes.NewValue(tftypes.String, "eu"),
+			}),
+		}),
+	})
+	removed, err := removeWriteOnlyFields(obj, fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedObj := newObjectValue(map[string]tftypes.Value{
+		"kind": tftypes.NewValue(tftypes.String, "Database"),
+		"spec": newObjectValue(map[string]tftypes.Value{
+			"user":     tftypes.NewValue(tftypes.String, "admin"),
+			"password": tftypes.NewValue(tftypes.String, nil),
+			"credentials": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"region": tftypes.NewValue(tftypes.String, "eu"),
+			}),
+		}),
+	})
+	if !removed.Equal(expectedObj) {
+		t.Errorf("expected %v got %v", expectedObj, removed)
+	}
+}
+
+func TestMergeWriteOnlyPayload(t *testing.T) {
+	dst := map[string]interface{}{
+		"spec": map[string]interface{}{"user": "admin"},
+	}
+	mergeWriteOnlyPayload(dst, map[string]interface{}{
+		"spec": map[string]interface{}{"password": "hunter2"},
+	})
+	expected := map[string]interface{}{
+		"spec": map[string]interface{}{"user": "admin", "password": "hunter2"},
+	}
+	if !reflect.DeepEqual(dst, expected) {
+		t.Errorf("expected %v got %v", expected, dst)
+	}
+}
+
+func TestWriteOnlyFieldsPrivateState(t *testing.T) {
+	p, err := privateStateWithWriteOnlyFields([]string{`spec["password"]`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields, err := writeOnlyFieldsFromPrivate(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fields, []string{`spec["password"]`}) {
+		t.Errorf("unexpected fields %v", fields)
+	}
+
+	// private state stored before write-only fields existed
+	legacy, err := tftypes.NewValue(legacyPrivateStateSchema, map[string]tftypes.Value{
+		"IsImported": tftypes.NewValue(tftypes.Bool, true),
+	}).MarshalMsgPack(legacyPrivateStateSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fields, err := writeOnlyFieldsFromPrivate(legacy); err != nil || len(fields) != 0 {
+		t.Errorf("expected no fields, got %v, %v", fields, err)
+	}
+	if imported, d := isImportedFlagFromPrivate(legacy); len(d) > 0 || !imported {
+		t.Errorf("expected the legacy import flag, got %v, %v", imported, d)
+	}
+}
+
+func TestValidateWriteOnlyManifest(t *testing.T) {
+	wo := newObjectValue(map[string]tftypes.Value{
+		"spec": newObjectValue(map[string]tftypes.Value{
+			"password": tftypes.NewValue(tftypes.String, "hunter2"),
+		}),
+	})
+	rev := tftypes.NewValue(tftypes.Number, 1)
+	if d := validateWriteOnlyManifest(map[string]tftypes.Value{"manifest_wo": wo, "manifest_wo_revision": rev}, true); len(d) != 0 {
+		t.Errorf("expected no diagnostics, got %v", d)
+	}
+	if d := validateWriteOnlyManifest(map[string]tftypes.Value{"manifest_wo": wo}, false); len(d) != 1 {
+		t.Errorf("expected an error when write-only attributes are not supported, got %v", d)
+	}
+	noWo := tftypes.NewValue(tftypes.DynamicPseudoType, nil)
+	if d := validateWriteOnlyManifest(map[string]tftypes.Value{"manifest_wo": noWo, "manifest_wo_revision": rev}, true); len(d) != 1 {
+		t.Errorf("expected an error for a revision without write-only manifest, got %v", d)
+	}
+	withKind := newObjectValue(map[string]tftypes.Value{
+		"kind": tftypes.NewValue(tftypes.String, "Secret"),
+	})
+	if d := validateWriteOnlyManifest(map[string]tftypes.Value{"manifest_wo": withKind}, true); len(d) != 1 {
+		t.Errorf("expected an error for kind in the write-only manifest, got %v", d)
+	}
+}
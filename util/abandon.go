@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	// DestroyBehaviorDelete deletes the object when the resource is destroyed.
+	DestroyBehaviorDelete = "delete"
+	// DestroyBehaviorAbandon leaves the object in the cluster when the resource is destroyed.
+	DestroyBehaviorAbandon = "abandon"
+)
+
+// AbandonOptions describes the object to release and the field managers Terraform used for it.
+type AbandonOptions struct {
+	Name string
+
+	// FieldManager is the name used by Terraform for server-side apply.
+	FieldManager string
+	// UpdateManagers are additional managers recorded for non-apply operations
+	// made by Terraform, e.g. the name derived from the client user agent.
+	UpdateManagers []string
+}
+
+// AbandonObject stops Terraform from managing an object without deleting it.
+//
+// The labels that only the Terraform managers own are removed, then the
+// managedFields entries of the Terraform managers are dropped, which gives up
+// the ownership of their fields and keeps their values.
+func AbandonObject(ctx context.Context, rs dynamic.ResourceInterface, opts AbandonOptions) error {
+	obj, err := rs.Get(ctx, opts.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	managers := map[string]bool{opts.FieldManager: true}
+	for _, m := range opts.UpdateManagers {
+		managers[m] = true
+	}
+
+	var terraform, others []map[string]interface{}
+	for _, e := range obj.GetManagedFields() {
+		if managers[e.Manager] {
+			terraform = append(terraform, fieldsV1Map(e.FieldsV1))
+		} else {
+			others = append(others, fieldsV1Map(e.FieldsV1))
+		}
+	}
+
+	// remove the labels that were only managed by Terraform
+	current := obj.GetLabels()
+	remove := map[string]interface{}{}
+	for _, k := range terraformOnlyLabels(terraform, others) {
+		if _, ok := current[k]; ok {
+			remove[k] = nil
+		}
+	}
+	if len(remove) > 0 {
+		data, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"labels": remove,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		obj, err = rs.Patch(ctx, opts.Name, types.MergePatchType, data, metav1.PatchOptions{FieldManager: opts.FieldManager})
+		if err != nil {
+			return fmt.Errorf("failed to remove labels managed by Terraform: %s", err)
+		}
+	}
+
+	return dropManagedFields(ctx, rs, obj, managers)
+}
+
+// ReleaseFieldManager removes the managedFields entries of manager from the
+// object, which gives up the ownership of its fields without changing their values.
+func ReleaseFieldManager(ctx context.Context, rs dynamic.ResourceInterface, name, manager string) error {
+	obj, err := rs.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return dropManagedFields(ctx, rs, obj, map[string]bool{manager: true})
+}
+
+func dropManagedFields(ctx context.Context, rs dynamic.ResourceInterface, obj *unstructured.Unstructured, managers map[string]bool) error {
+	entries := obj.GetManagedFields()
+	keep := []interface{}{}
+	for _, e := range entries {
+		if !managers[e.Manager] {
+			keep = append(keep, e)
+		}
+	}
+	if len(keep) == len(entries) {
+		return nil
+	}
+	if len(keep) == 0 {
+		// an empty list leaves managedFields untouched, a single empty entry clears it
+		keep = append(keep, map[string]interface{}{})
+	}
+	data, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"resourceVersion": obj.GetResourceVersion(),
+			"managedFields":   keep,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = rs.Patch(ctx, obj.GetName(), types.MergePatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to remove Terraform from managedFields: %s", err)
+	}
+	return nil
+}
+
+func fieldsV1Map(f *metav1.FieldsV1) map[string]interface{} {
+	m := map[string]interface{}{}
+	if f == nil {
+		return m
+	}
+	_ = json.Unmarshal(f.Raw, &m)
+	return m
+}
+
+// terraformOnlyLabels returns the label keys owned by the Terraform managers and no other manager.
+func terraformOnlyLabels(terraform, others []map[string]interface{}) []string {
+	owned := map[string]bool{}
+	for _, f := range others {
+		for k := range labelFields(f) {
+			owned[k] = true
+		}
+	}
+	var keys []string
+	for _, f := range terraform {
+		for k := range labelFields(f) {
+			if k != "." && !owned[k] {
+				owned[k] = true
+				keys = append(keys, strings.TrimPrefix(k, "f:"))
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func labelFields(f map[string]interface{}) map[string]interface{} {
+	md, _ := f["f:metadata"].(map[string]interface{})
+	labels, _ := md["f:labels"].(map[string]interface{})
+	return labels
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func fieldsFromJSON(t *testing.T, s string) map[string]interface{} {
+	m := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestTerraformOnlyLabels(t *testing.T) {
+	terraform := []map[string]interface{}{
+		fieldsFromJSON(t, `{"f:metadata":{"f:labels":{".":{},"f:app":{},"f:managed-by":{}}}}`),
+	}
+	others := []map[string]interface{}{
+		fieldsFromJSON(t, `{"f:metadata":{"f:labels":{"f:app":{}}},"f:spec":{}}`),
+	}
+	expected := []string{"managed-by"}
+	if labels := terraformOnlyLabels(terraform, others); !reflect.DeepEqual(expected, labels) {
+		t.Errorf("expected %#v got %#v", expected, labels)
+	}
+}
+
+func TestAbandonObject(t *testing.T) {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetName("test")
+	obj.SetNamespace("default")
+	obj.SetLabels(map[string]string{"app": "web", "managed-by": "terraform"})
+	obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{
+			Manager:    "Terraform",
+			Operation:  metav1.ManagedFieldsOperationApply,
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:app":{},"f:managed-by":{}}},"f:data":{"f:key":{}}}`)},
+		},
+		{
+			Manager:    "argocd",
+			Operation:  metav1.ManagedFieldsOperationApply,
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:app":{}}}}`)},
+		},
+	})
+	unstructured.SetNestedField(obj.Object, "value", "data", "key")
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	rs := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), obj).Resource(gvr).Namespace("default")
+
+	err := AbandonObject(context.Background(), rs, AbandonOptions{Name: "test", FieldManager: "Terraform"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := rs.Get(context.Background(), "test", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if labels := out.GetLabels(); !reflect.DeepEqual(labels, map[string]string{"app": "web"}) {
+		t.Errorf("expected the labels only managed by Terraform to be removed, got %#v", labels)
+	}
+	if v, _, _ := unstructured.NestedString(out.Object, "data", "key"); v != "value" {
+		t.Errorf("expected the fields owned by Terraform to be kept, got %q", v)
+	}
+	var managers []string
+	for _, e := range out.GetManagedFields() {
+		managers = append(managers, e.Manager)
+	}
+	if !reflect.DeepEqual(managers, []string{"argocd"}) {
+		t.Errorf("expected only the argocd managedFields entry to be left, got %v", managers)
+	}
+}
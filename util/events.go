@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// LastWarningEvents returns the last warnings of the objects of namespace
+// with the given UIDs, such as a workload and the pods it owns.
+func LastWarningEvents(ctx context.Context, conn kubernetes.Interface, namespace string, uids map[types.UID]bool, limit int) ([]corev1.Event, error) {
+	out, err := conn.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("type", corev1.EventTypeWarning).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var events []corev1.Event
+	for _, e := range out.Items {
+		if uids[e.InvolvedObject.UID] {
+			events = append(events, e)
+		}
+	}
+	return LastWarnings(events, limit), nil
+}
+
+// LastWarnings returns up to limit warnings out of events, latest first,
+// skipping the ones with the same message.
+func LastWarnings(events []corev1.Event, limit int) []corev1.Event {
+	// It would be better to sort & filter on the server-side
+	// but API doesn't seem to support it
+	var warnings []corev1.Event
+
+	// Bring latest events to the top, for easy access
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].LastTimestamp.After(events[j].LastTimestamp.Time)
+	})
+
+	warnCount := 0
+	uniqueWarnings := make(map[string]corev1.Event, 0)
+	for _, e := range events {
+		if warnCount >= limit {
+			break
+		}
+
+		if e.Type == corev1.EventTypeWarning {
+			_, found := uniqueWarnings[e.Message]
+			if found {
+				continue
+			}
+			warnings = append(warnings, e)
+			uniqueWarnings[e.Message] = e
+			warnCount++
+		}
+	}
+
+	return warnings
+}
+
+// StringifyEvents formats events as a list to add to an error.
+func StringifyEvents(events []corev1.Event) string {
+	var output string
+	for _, e := range events {
+		output += fmt.Sprintf("\n   * %s (%s): %s: %s",
+			e.InvolvedObject.Name, e.InvolvedObject.Kind,
+			e.Reason, e.Message)
+	}
+	return output
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestLastWarningEvents(t *testing.T) {
+	now := time.Now()
+	event := func(name string, uid types.UID, eventType, message string, age time.Duration) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: string(uid), UID: uid},
+			Type:           eventType,
+			Reason:         "Failed",
+			Message:        message,
+			LastTimestamp:  metav1.NewTime(now.Add(-age)),
+		}
+	}
+	conn := fake.NewSimpleClientset(
+		event("old", "pod", corev1.EventTypeWarning, "pull failed", 3*time.Minute),
+		event("duplicate", "pod", corev1.EventTypeWarning, "pull failed", time.Minute),
+		event("normal", "pod", corev1.EventTypeNormal, "pulling", 0),
+		event("owner", "deployment", corev1.EventTypeWarning, "quota exceeded", 2*time.Minute),
+		event("other", "other", corev1.EventTypeWarning, "unrelated", 0),
+	)
+
+	events, err := LastWarningEvents(context.Background(), conn, "default", map[types.UID]bool{"pod": true, "deployment": true}, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, e := range events {
+		names = append(names, e.Name)
+	}
+	if expected := []string{"duplicate", "owner"}; !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected events %v, got %v", expected, names)
+	}
+
+	events, err = LastWarningEvents(context.Background(), conn, "default", map[types.UID]bool{"pod": true, "deployment": true}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Name != "duplicate" {
+		t.Fatalf("expected the latest event only, got %v", events)
+	}
+}
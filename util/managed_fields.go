@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ManagedFieldsOnly returns a copy of obj with only the fields that manager
+// owns through server-side apply, as recorded in `metadata.managedFields`.
+// The apiVersion, kind, name and namespace of the object are always kept.
+func ManagedFieldsOnly(obj *unstructured.Unstructured, manager string) map[string]interface{} {
+	var owned map[string]interface{}
+	for _, e := range obj.GetManagedFields() {
+		if e.Manager == manager && e.Operation == metav1.ManagedFieldsOperationApply {
+			owned = fieldsV1Map(e.FieldsV1)
+			break
+		}
+	}
+	out := map[string]interface{}{}
+	if owned != nil {
+		out, _ = filterOwnedFields(obj.Object, owned).(map[string]interface{})
+	}
+	out["apiVersion"] = obj.GetAPIVersion()
+	out["kind"] = obj.GetKind()
+	md, _ := out["metadata"].(map[string]interface{})
+	if md == nil {
+		md = map[string]interface{}{}
+		out["metadata"] = md
+	}
+	md["name"] = obj.GetName()
+	if ns := obj.GetNamespace(); ns != "" {
+		md["namespace"] = ns
+	}
+	return out
+}
+
+// filterOwnedFields keeps the parts of v listed in the FieldsV1 set owned.
+// A field without children in the set is owned as a whole.
+func filterOwnedFields(v interface{}, owned map[string]interface{}) interface{} {
+	if !hasOwnedChildren(owned) {
+		return v
+	}
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := map[string]interface{}{}
+		for k, sub := range owned {
+			name, ok := strings.CutPrefix(k, "f:")
+			if !ok {
+				continue
+			}
+			fv, ok := v[name]
+			if !ok {
+				continue
+			}
+			sm, _ := sub.(map[string]interface{})
+			out[name] = filterOwnedFields(fv, sm)
+		}
+		return out
+	case []interface{}:
+		out := []interface{}{}
+		for i, e := range v {
+			for k, sub := range owned {
+				if listElementMatches(k, i, e) {
+					sm, _ := sub.(map[string]interface{})
+					out = append(out, filterOwnedFields(e, sm))
+					break
+				}
+			}
+		}
+		return out
+	}
+	return v
+}
+
+func hasOwnedChildren(owned map[string]interface{}) bool {
+	for k := range owned {
+		if k != "." {
+			return true
+		}
+	}
+	return false
+}
+
+// listElementMatches reports whether the FieldsV1 key of a list element,
+// `k:{...}` for an associative key, `v:...` for a value or `i:N` for an
+// index, designates the element e at index i.
+func listElementMatches(key string, i int, e interface{}) bool {
+	switch {
+	case strings.HasPrefix(key, "i:"):
+		n, err := strconv.Atoi(key[2:])
+		return err == nil && n == i
+	case strings.HasPrefix(key, "v:"):
+		var val interface{}
+		if err := json.Unmarshal([]byte(key[2:]), &val); err != nil {
+			return false
+		}
+		return jsonEqual(val, e)
+	case strings.HasPrefix(key, "k:"):
+		var keys map[string]interface{}
+		if err := json.Unmarshal([]byte(key[2:]), &keys); err != nil {
+			return false
+		}
+		m, ok := e.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, kv := range keys {
+			if !jsonEqual(kv, m[k]) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func jsonEqual(a, b interface{}) bool {
+	ja, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	jb, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return string(ja) == string(jb)
+}
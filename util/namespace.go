@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// DefaultNamespace is used when neither the provider nor the kubeconfig context set a namespace.
+const DefaultNamespace = "default"
+
+// ContextNamespace returns the namespace of the kubeconfig context selected by
+// currentContext, or of the current context of the kubeconfig when it is empty.
+//
+// Unlike ClientConfig.Namespace, the namespace of the pod the provider runs in
+// is never used.
+func ContextNamespace(cc clientcmd.ClientConfig, currentContext string) string {
+	raw, err := cc.RawConfig()
+	if err != nil {
+		return DefaultNamespace
+	}
+	if currentContext == "" {
+		currentContext = raw.CurrentContext
+	}
+	if c, ok := raw.Contexts[currentContext]; ok && c.Namespace != "" {
+		return c.Namespace
+	}
+	return DefaultNamespace
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"testing"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestContextNamespace(t *testing.T) {
+	config := clientcmdapi.Config{
+		CurrentContext: "dev",
+		Contexts: map[string]*clientcmdapi.Context{
+			"dev":  {Cluster: "c", Namespace: "team-a"},
+			"prod": {Cluster: "c"},
+		},
+	}
+	cases := map[string]struct {
+		context   string
+		namespace string
+	}{
+		"current context":           {"", "team-a"},
+		"selected context":          {"dev", "team-a"},
+		"context without namespace": {"prod", "default"},
+		"unknown context":           {"missing", "default"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cc := clientcmd.NewDefaultClientConfig(config, &clientcmd.ConfigOverrides{})
+			if ns := ContextNamespace(cc, tc.context); ns != tc.namespace {
+				t.Errorf("expected namespace %q got %q", tc.namespace, ns)
+			}
+		})
+	}
+}
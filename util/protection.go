@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+const (
+	// ProtectionModeDeny refuses operations on protected objects.
+	ProtectionModeDeny = "deny"
+	// ProtectionModeWarn only warns about operations on protected objects.
+	ProtectionModeWarn = "warn"
+)
+
+// Operations checked against the protection settings.
+const (
+	OperationCreate = "create"
+	OperationUpdate = "update"
+	OperationDelete = "delete"
+)
+
+// Protection holds the provider level `protected_namespaces` and
+// `protected_kinds` settings. A nil Protection protects nothing.
+type Protection struct {
+	// Namespaces are namespace names or glob patterns, e.g. `kube-*`.
+	Namespaces []string
+	// Kinds are object kinds, e.g. `CustomResourceDefinition`, matched regardless of case.
+	Kinds []string
+	Mode  string
+}
+
+// NewProtection returns the protection settings, or nil when nothing is protected.
+func NewProtection(namespaces, kinds []string, mode string) (*Protection, error) {
+	switch mode {
+	case "":
+		mode = ProtectionModeDeny
+	case ProtectionModeDeny, ProtectionModeWarn:
+	default:
+		return nil, fmt.Errorf("invalid protection mode %q, must be one of %q or %q", mode, ProtectionModeDeny, ProtectionModeWarn)
+	}
+	for _, ns := range namespaces {
+		if _, err := path.Match(ns, ""); err != nil {
+			return nil, fmt.Errorf("invalid protected namespace pattern %q: %s", ns, err)
+		}
+	}
+	if len(namespaces) == 0 && len(kinds) == 0 {
+		return nil, nil
+	}
+	return &Protection{Namespaces: namespaces, Kinds: kinds, Mode: mode}, nil
+}
+
+// Warn reports whether operations on protected objects are allowed with a warning.
+func (p *Protection) Warn() bool {
+	return p != nil && p.Mode == ProtectionModeWarn
+}
+
+// Check returns an error when op on the object is protected. The name of a
+// Namespace object is matched against the protected namespaces.
+func (p *Protection) Check(op, kind, namespace, name string) error {
+	if p == nil {
+		return nil
+	}
+	object := describeObject(kind, namespace, name)
+	for _, k := range p.Kinds {
+		if strings.EqualFold(k, kind) {
+			return fmt.Errorf("refusing to %s %s: kind %q is listed in the provider `protected_kinds`", op, object, kind)
+		}
+	}
+	if kind == "Namespace" && namespace == "" {
+		namespace = name
+	}
+	for _, ns := range p.Namespaces {
+		if ok, _ := path.Match(ns, namespace); ok && namespace != "" {
+			return fmt.Errorf("refusing to %s %s: namespace %q is listed in the provider `protected_namespaces`", op, object, namespace)
+		}
+	}
+	return nil
+}
+
+// ReadOnlyError returns the error of an operation refused because the provider
+// is configured with `read_only = true`.
+func ReadOnlyError(op, kind, namespace, name string) error {
+	return fmt.Errorf("refusing to %s %s: the provider is configured with `read_only = true`", op, describeObject(kind, namespace, name))
+}
+
+func describeObject(kind, namespace, name string) string {
+	object := kind
+	if name != "" {
+		object = fmt.Sprintf("%s %q", kind, name)
+	}
+	if namespace != "" {
+		object = fmt.Sprintf("%s in namespace %q", object, namespace)
+	}
+	return object
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"testing"
+)
+
+func TestProtectionCheck(t *testing.T) {
+	p, err := NewProtection([]string{"kube-*", "prod"}, []string{"CustomResourceDefinition"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		op, kind, namespace, name string
+		err                       string
+	}{
+		{OperationCreate, "ConfigMap", "default", "app", ""},
+		{OperationUpdate, "ConfigMap", "kube-system", "coredns", "refusing to update ConfigMap \"coredns\" in namespace \"kube-system\": namespace \"kube-system\" is listed in the provider `protected_namespaces`"},
+		{OperationDelete, "Secret", "prod", "", "refusing to delete Secret in namespace \"prod\": namespace \"prod\" is listed in the provider `protected_namespaces`"},
+		{OperationDelete, "Namespace", "", "kube-public", "refusing to delete Namespace \"kube-public\": namespace \"kube-public\" is listed in the provider `protected_namespaces`"},
+		{OperationDelete, "Namespace", "", "production", ""},
+		{OperationDelete, "customresourcedefinition", "", "widgets.example.com", "refusing to delete customresourcedefinition \"widgets.example.com\": kind \"customresourcedefinition\" is listed in the provider `protected_kinds`"},
+		{OperationCreate, "ClusterRole", "", "admin", ""},
+	}
+	for _, tc := range cases {
+		err := p.Check(tc.op, tc.kind, tc.namespace, tc.name)
+		switch {
+		case tc.err == "" && err != nil:
+			t.Errorf("expected no error for %s %s/%s, got %q", tc.kind, tc.namespace, tc.name, err)
+		case tc.err != "" && (err == nil || err.Error() != tc.err):
+			t.Errorf("expected error %q got %v", tc.err, err)
+		}
+	}
+
+	var none *Protection
+	if err := none.Check(OperationDelete, "Namespace", "", "kube-system"); err != nil {
+		t.Errorf("expected a nil protection to allow everything, got %q", err)
+	}
+}
+
+func TestNewProtection(t *testing.T) {
+	p, err := NewProtection(nil, nil, ProtectionModeWarn)
+	if err != nil || p != nil {
+		t.Errorf("expected no protection, got %v, %v", p, err)
+	}
+	p, err = NewProtection([]string{"kube-system"}, nil, ProtectionModeWarn)
+	if err != nil || !p.Warn() {
+		t.Errorf("expected a warning protection, got %v, %v", p, err)
+	}
+	if _, err := NewProtection([]string{"kube-system"}, nil, "block"); err == nil {
+		t.Error("expected an error for an invalid mode")
+	}
+	if _, err := NewProtection([]string{"kube-["}, nil, ""); err == nil {
+		t.Error("expected an error for an invalid pattern")
+	}
+}
+
+func TestReadOnlyError(t *testing.T) {
+	err := ReadOnlyError(OperationDelete, "ConfigMap", "default", "app")
+	expected := "refusing to delete ConfigMap \"app\" in namespace \"default\": the provider is configured with `read_only = true`"
+	if err.Error() != expected {
+		t.Errorf("expected %q got %q", expected, err)
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ReadinessStatus is the readiness of an object, after the statuses of kstatus.
+type ReadinessStatus string
+
+const (
+	// ReadinessCurrent means the object is reconciled and ready.
+	ReadinessCurrent ReadinessStatus = "Current"
+	// ReadinessInProgress means the object is being reconciled.
+	ReadinessInProgress ReadinessStatus = "InProgress"
+	// ReadinessFailed means the object cannot become ready without a change.
+	ReadinessFailed ReadinessStatus = "Failed"
+)
+
+// Readiness holds the readiness of an object, along with why it is not ready.
+type Readiness struct {
+	Status  ReadinessStatus
+	Message string
+}
+
+func current() Readiness {
+	return Readiness{Status: ReadinessCurrent}
+}
+
+func inProgress(format string, a ...interface{}) Readiness {
+	return Readiness{Status: ReadinessInProgress, Message: fmt.Sprintf(format, a...)}
+}
+
+func failed(format string, a ...interface{}) Readiness {
+	return Readiness{Status: ReadinessFailed, Message: fmt.Sprintf(format, a...)}
+}
+
+// ComputeReadiness computes the readiness of obj the way kstatus does:
+//   - the controller must have observed the latest generation of the object
+//   - the status fields of the built-in workloads must show the rollout is done
+//   - for other kinds, a Stalled condition fails, a Reconciling condition is in
+//     progress, and a Ready or Available condition must be True
+//
+// Objects without any of these are ready as soon as they exist.
+func ComputeReadiness(obj *unstructured.Unstructured) Readiness {
+	if obj.GetDeletionTimestamp() != nil {
+		return inProgress("the object is being deleted")
+	}
+	if observed, ok := nestedInt(obj.Object, "status", "observedGeneration"); ok && observed < obj.GetGeneration() {
+		return inProgress("the controller observed generation %d, expected %d", observed, obj.GetGeneration())
+	}
+
+	var r Readiness
+	switch obj.GroupVersionKind().GroupKind().String() {
+	case "Deployment.apps":
+		r = deploymentReadiness(obj)
+	case "StatefulSet.apps":
+		r = statefulSetReadiness(obj)
+	case "DaemonSet.apps":
+		r = daemonSetReadiness(obj)
+	case "ReplicaSet.apps", "ReplicationController":
+		r = replicaSetReadiness(obj)
+	case "Pod":
+		r = podReadiness(obj)
+	case "Job.batch":
+		r = jobReadiness(obj)
+	case "PersistentVolumeClaim":
+		r = phaseReadiness(obj, "Bound")
+	case "Namespace":
+		r = phaseReadiness(obj, "Active")
+	case "Service":
+		r = serviceReadiness(obj)
+	case "CustomResourceDefinition.apiextensions.k8s.io":
+		r = conditionReadiness(obj, "Established")
+	default:
+		r = current()
+	}
+	if r.Status != ReadinessCurrent {
+		return r
+	}
+	return genericReadiness(obj)
+}
+
+// genericReadiness checks the conditions kstatus expects of well-behaved controllers.
+func genericReadiness(obj *unstructured.Unstructured) Readiness {
+	if c, ok := condition(obj, "Stalled"); ok && c["status"] == "True" {
+		return failed("Stalled: %s", conditionMessage(c))
+	}
+	if c, ok := condition(obj, "Reconciling"); ok && c["status"] == "True" {
+		return inProgress("Reconciling: %s", conditionMessage(c))
+	}
+	for _, t := range []string{"Ready", "Available"} {
+		if c, ok := condition(obj, t); ok {
+			if c["status"] != "True" {
+				return inProgress("%s is %s: %s", t, c["status"], conditionMessage(c))
+			}
+			break
+		}
+	}
+	return current()
+}
+
+func deploymentReadiness(obj *unstructured.Unstructured) Readiness {
+	if c, ok := condition(obj, "Progressing"); ok && c["reason"] == "ProgressDeadlineExceeded" {
+		return failed("ProgressDeadlineExceeded: %s", conditionMessage(c))
+	}
+	replicas := specReplicas(obj)
+	updated, _ := nestedInt(obj.Object, "status", "updatedReplicas")
+	total, _ := nestedInt(obj.Object, "status", "replicas")
+	available, _ := nestedInt(obj.Object, "status", "availableReplicas")
+	ready, _ := nestedInt(obj.Object, "status", "readyReplicas")
+	switch {
+	case updated < replicas:
+		return inProgress("%d of %d replicas are updated", updated, replicas)
+	case total > updated:
+		return inProgress("%d old replicas are pending termination", total-updated)
+	case available < updated:
+		return inProgress("%d of %d updated replicas are available", available, updated)
+	case ready < replicas:
+		return inProgress("%d of %d replicas are ready", ready, replicas)
+	}
+	return current()
+}
+
+func statefulSetReadiness(obj *unstructured.Unstructured) Readiness {
+	replicas := specReplicas(obj)
+	ready, _ := nestedInt(obj.Object, "status", "readyReplicas")
+	if ready < replicas {
+		return inProgress("%d of %d replicas are ready", ready, replicas)
+	}
+	if strategy, _, _ := unstructured.NestedString(obj.Object, "spec", "updateStrategy", "type"); strategy == "OnDelete" {
+		return current()
+	}
+	if partition, ok := nestedInt(obj.Object, "spec", "updateStrategy", "rollingUpdate", "partition"); ok && partition > 0 {
+		updated, _ := nestedInt(obj.Object, "status", "updatedReplicas")
+		if updated < replicas-partition {
+			return inProgress("%d of %d replicas above the partition are updated", updated, replicas-partition)
+		}
+		return current()
+	}
+	currentRevision, _, _ := unstructured.NestedString(obj.Object, "status", "currentRevision")
+	updateRevision, _, _ := unstructured.NestedString(obj.Object, "status", "updateRevision")
+	if currentRevision != updateRevision {
+		return inProgress("revision %s is rolling out", updateRevision)
+	}
+	return current()
+}
+
+func daemonSetReadiness(obj *unstructured.Unstructured) Readiness {
+	desired, _ := nestedInt(obj.Object, "status", "desiredNumberScheduled")
+	updated, _ := nestedInt(obj.Object, "status", "updatedNumberScheduled")
+	available, _ := nestedInt(obj.Object, "status", "numberAvailable")
+	ready, _ := nestedInt(obj.Object, "status", "numberReady")
+	switch {
+	case updated < desired:
+		return inProgress("%d of %d pods are updated", updated, desired)
+	case available < desired:
+		return inProgress("%d of %d pods are available", available, desired)
+	case ready < desired:
+		return inProgress("%d of %d pods are ready", ready, desired)
+	}
+	return current()
+}
+
+func replicaSetReadiness(obj *unstructured.Unstructured) Readiness {
+	replicas := specReplicas(obj)
+	available, _ := nestedInt(obj.Object, "status", "availableReplicas")
+	ready, _ := nestedInt(obj.Object, "status", "readyReplicas")
+	switch {
+	case available < replicas:
+		return inProgress("%d of %d replicas are available", available, replicas)
+	case ready < replicas:
+		return inProgress("%d of %d replicas are ready", ready, replicas)
+	}
+	return current()
+}
+
+func podReadiness(obj *unstructured.Unstructured) Readiness {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	switch phase {
+	case "Succeeded":
+		return current()
+	case "Failed":
+		message, _, _ := unstructured.NestedString(obj.Object, "status", "message")
+		return failed("the pod failed: %s", message)
+	}
+	return conditionReadiness(obj, "Ready")
+}
+
+func jobReadiness(obj *unstructured.Unstructured) Readiness {
+	if c, ok := condition(obj, "Failed"); ok && c["status"] == "True" {
+		return failed("the job failed: %s", conditionMessage(c))
+	}
+	if c, ok := condition(obj, "Complete"); ok && c["status"] == "True" {
+		return current()
+	}
+	succeeded, _ := nestedInt(obj.Object, "status", "succeeded")
+	return inProgress("the job is running, %d pods succeeded", succeeded)
+}
+
+func phaseReadiness(obj *unstructured.Unstructured, expected string) Readiness {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	if phase != expected {
+		return inProgress("phase is %q, expected %q", phase, expected)
+	}
+	return current()
+}
+
+func serviceReadiness(obj *unstructured.Unstructured) Readiness {
+	if t, _, _ := unstructured.NestedString(obj.Object, "spec", "type"); t != "LoadBalancer" {
+		return current()
+	}
+	ingress, _, _ := unstructured.NestedSlice(obj.Object, "status", "loadBalancer", "ingress")
+	if len(ingress) == 0 {
+		return inProgress("the load balancer has no ingress yet")
+	}
+	return current()
+}
+
+// conditionReadiness requires the condition of type t to be True.
+func conditionReadiness(obj *unstructured.Unstructured, t string) Readiness {
+	c, ok := condition(obj, t)
+	if !ok {
+		return inProgress("condition %s is not set", t)
+	}
+	if c["status"] != "True" {
+		return inProgress("%s is %s: %s", t, c["status"], conditionMessage(c))
+	}
+	return current()
+}
+
+// condition returns the status condition of obj of type t.
+func condition(obj *unstructured.Unstructured, t string) (map[string]interface{}, bool) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		if c, ok := c.(map[string]interface{}); ok && c["type"] == t {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+func conditionMessage(c map[string]interface{}) string {
+	reason, _ := c["reason"].(string)
+	message, _ := c["message"].(string)
+	return strings.TrimSpace(reason + " " + message)
+}
+
+// specReplicas returns the desired number of replicas, which defaults to 1.
+func specReplicas(obj *unstructured.Unstructured) int64 {
+	if replicas, ok := nestedInt(obj.Object, "spec", "replicas"); ok {
+		return replicas
+	}
+	return 1
+}
+
+// nestedInt returns the integer at the given path, which is a float64 in
+// objects decoded from JSON outside of the API machinery.
+func nestedInt(obj map[string]interface{}, fields ...string) (int64, bool) {
+	v, ok, _ := unstructured.NestedFieldNoCopy(obj, fields...)
+	if !ok {
+		return 0, false
+	}
+	switch n := v.(type) {
+	case int64:
+		return n, true
+	case int:
+		return int64(n), true
+	case float64:
+		return int64(n), true
+	}
+	return 0, false
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestComputeReadiness(t *testing.T) {
+	cases := []struct {
+		name   string
+		obj    string
+		status ReadinessStatus
+	}{
+		{
+			"configmap",
+			`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "app"}}`,
+			ReadinessCurrent,
+		},
+		{
+			"generation not observed",
+			`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"generation": 3},
+			  "spec": {"replicas": 1},
+			  "status": {"observedGeneration": 2, "replicas": 1, "updatedReplicas": 1, "availableReplicas": 1, "readyReplicas": 1}}`,
+			ReadinessInProgress,
+		},
+		{
+			"deployment rolled out",
+			`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"generation": 3},
+			  "spec": {"replicas": 2},
+			  "status": {"observedGeneration": 3, "replicas": 2, "updatedReplicas": 2, "availableReplicas": 2, "readyReplicas": 2,
+			             "conditions": [{"type": "Available", "status": "True"}]}}`,
+			ReadinessCurrent,
+		},
+		{
+			"deployment with old replicas",
+			`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"generation": 3},
+			  "spec": {"replicas": 2},
+			  "status": {"observedGeneration": 3, "replicas": 3, "updatedReplicas": 2, "availableReplicas": 2, "readyReplicas": 2}}`,
+			ReadinessInProgress,
+		},
+		{
+			"deployment past its deadline",
+			`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"generation": 3},
+			  "spec": {"replicas": 2},
+			  "status": {"observedGeneration": 3, "conditions": [{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded"}]}}`,
+			ReadinessFailed,
+		},
+		{
+			"statefulset rolling out",
+			`{"apiVersion": "apps/v1", "kind": "StatefulSet", "metadata": {"generation": 1},
+			  "spec": {"replicas": 1},
+			  "status": {"observedGeneration": 1, "readyReplicas": 1, "currentRevision": "app-1", "updateRevision": "app-2"}}`,
+			ReadinessInProgress,
+		},
+		{
+			"statefulset on delete",
+			`{"apiVersion": "apps/v1", "kind": "StatefulSet", "metadata": {"generation": 1},
+			  "spec": {"replicas": 1, "updateStrategy": {"type": "OnDelete"}},
+			  "status": {"observedGeneration": 1, "readyReplicas": 1, "currentRevision": "app-1", "updateRevision": "app-2"}}`,
+			ReadinessCurrent,
+		},
+		{
+			"daemonset not available",
+			`{"apiVersion": "apps/v1", "kind": "DaemonSet", "metadata": {"generation": 1},
+			  "status": {"observedGeneration": 1, "desiredNumberScheduled": 3, "updatedNumberScheduled": 3, "numberAvailable": 2, "numberReady": 3}}`,
+			ReadinessInProgress,
+		},
+		{
+			"pod ready",
+			`{"apiVersion": "v1", "kind": "Pod",
+			  "status": {"phase": "Running", "conditions": [{"type": "Ready", "status": "True"}]}}`,
+			ReadinessCurrent,
+		},
+		{
+			"pod failed",
+			`{"apiVersion": "v1", "kind": "Pod", "status": {"phase": "Failed"}}`,
+			ReadinessFailed,
+		},
+		{
+			"job running",
+			`{"apiVersion": "batch/v1", "kind": "Job", "status": {"active": 1}}`,
+			ReadinessInProgress,
+		},
+		{
+			"job complete",
+			`{"apiVersion": "batch/v1", "kind": "Job", "status": {"conditions": [{"type": "Complete", "status": "True"}]}}`,
+			ReadinessCurrent,
+		},
+		{
+			"pending load balancer",
+			`{"apiVersion": "v1", "kind": "Service", "spec": {"type": "LoadBalancer"}, "status": {"loadBalancer": {}}}`,
+			ReadinessInProgress,
+		},
+		{
+			"pvc bound",
+			`{"apiVersion": "v1", "kind": "PersistentVolumeClaim", "status": {"phase": "Bound"}}`,
+			ReadinessCurrent,
+		},
+		{
+			"custom resource not ready",
+			`{"apiVersion": "example.com/v1", "kind": "Widget", "metadata": {"generation": 2},
+			  "status": {"observedGeneration": 2, "conditions": [{"type": "Ready", "status": "False", "reason": "Provisioning"}]}}`,
+			ReadinessInProgress,
+		},
+		{
+			"custom resource available",
+			`{"apiVersion": "example.com/v1", "kind": "Widget",
+			  "status": {"conditions": [{"type": "Available", "status": "True"}]}}`,
+			ReadinessCurrent,
+		},
+		{
+			"custom resource reconciling",
+			`{"apiVersion": "example.com/v1", "kind": "Widget",
+			  "status": {"conditions": [{"type": "Ready", "status": "True"}, {"type": "Reconciling", "status": "True"}]}}`,
+			ReadinessInProgress,
+		},
+		{
+			"custom resource stalled",
+			`{"apiVersion": "example.com/v1", "kind": "Widget",
+			  "status": {"conditions": [{"type": "Stalled", "status": "True", "message": "invalid spec"}]}}`,
+			ReadinessFailed,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{}
+			if err := obj.UnmarshalJSON([]byte(tc.obj)); err != nil {
+				t.Fatal(err)
+			}
+			r := ComputeReadiness(obj)
+			if r.Status != tc.status {
+				t.Errorf("expected %s, got %s: %s", tc.status, r.Status, r.Message)
+			}
+			if r.Status != ReadinessCurrent && r.Message == "" {
+				t.Error("expected a message")
+			}
+		})
+	}
+}
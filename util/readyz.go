@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
+)
+
+const readyzPollInterval = 2 * time.Second
+
+// APIServerNotReadyError is returned by WaitForAPIServerReady when the API server
+// did not report itself as ready before the timeout expired.
+type APIServerNotReadyError struct {
+	Timeout      time.Duration
+	FailedChecks []string
+	LastError    error
+}
+
+func (e *APIServerNotReadyError) Error() string {
+	msg := fmt.Sprintf("the Kubernetes API server did not become ready within %s", e.Timeout)
+	if len(e.FailedChecks) > 0 {
+		msg += "\n\nThe following readiness checks were failing:"
+		for _, c := range e.FailedChecks {
+			msg += "\n  - " + c
+		}
+	}
+	if e.LastError != nil {
+		msg += fmt.Sprintf("\n\nLast error: %s", e.LastError)
+	}
+	return msg
+}
+
+// WaitForAPIServerReady polls the "/readyz" endpoint of the API server
+// until it reports healthy or the timeout expires.
+//
+// A response of Unauthorized or Forbidden is taken to mean the API server is up and
+// serving requests: credential problems are left for the actual API calls to report.
+func WaitForAPIServerReady(ctx context.Context, rc rest.Interface, timeout time.Duration) error {
+	var failedChecks []string
+	var lastErr error
+
+	err := wait.PollUntilContextTimeout(ctx, readyzPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		body, err := rc.Get().AbsPath("/readyz").Param("verbose", "").DoRaw(ctx)
+		if err == nil {
+			return true, nil
+		}
+		if apierrors.IsUnauthorized(err) || apierrors.IsForbidden(err) {
+			return true, nil
+		}
+		lastErr = err
+		if fc := ParseReadyzFailedChecks(body); len(fc) > 0 {
+			failedChecks = fc
+		}
+		return false, nil
+	})
+	if err == nil {
+		return nil
+	}
+	if !wait.Interrupted(err) {
+		return err
+	}
+	return &APIServerNotReadyError{
+		Timeout:      timeout,
+		FailedChecks: failedChecks,
+		LastError:    lastErr,
+	}
+}
+
+// ParseReadyzFailedChecks extracts the names and reasons of the failed checks
+// from the verbose output of the "/readyz" endpoint.
+//
+// Failed checks are reported one per line in the format: "[-]etcd failed: reason withheld"
+func ParseReadyzFailedChecks(body []byte) []string {
+	var failed []string
+	s := bufio.NewScanner(bytes.NewReader(body))
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if c, ok := strings.CutPrefix(line, "[-]"); ok {
+			failed = append(failed, c)
+		}
+	}
+	return failed
+}
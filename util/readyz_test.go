@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseReadyzFailedChecks(t *testing.T) {
+	cases := []struct {
+		name     string
+		body     string
+		expected []string
+	}{
+		{
+			name: "healthy",
+			body: "[+]ping ok\n[+]log ok\n[+]etcd ok\nreadyz check passed\n",
+		},
+		{
+			name: "failing",
+			body: "[+]ping ok\n[-]etcd failed: reason withheld\n[+]informer-sync ok\n[-]poststarthook/rbac/bootstrap-roles failed: not finished\nreadyz check failed\n",
+			expected: []string{
+				"etcd failed: reason withheld",
+				"poststarthook/rbac/bootstrap-roles failed: not finished",
+			},
+		},
+		{
+			name: "not verbose",
+			body: "connection refused",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			failed := ParseReadyzFailedChecks([]byte(tc.body))
+			if !reflect.DeepEqual(tc.expected, failed) {
+				t.Errorf("expected %#v got %#v", tc.expected, failed)
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+)
+
+// RedactedValue replaces sensitive values in logs.
+const RedactedValue = "***"
+
+var bearerToken = regexp.MustCompile(`(?i)(bearer\s+)[A-Za-z0-9\-._~+/]+=*`)
+
+// lastAppliedAnnotation holds the configuration applied by kubectl, which
+// includes the data of Secrets.
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// redactedHeaders are the request headers that carry credentials.
+var redactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// NewRedactingLoggingTransport returns a transport that logs requests and
+// responses at debug level like logging.NewSubsystemLoggingHTTPTransport, but
+// masks credentials and sensitive content first: the data of Secrets, tokens
+// of TokenRequests, authorization headers and bearer tokens.
+func NewRedactingLoggingTransport(subsystem string, rt http.RoundTripper) http.RoundTripper {
+	return &redactingLoggingTransport{subsystem: subsystem, transport: rt}
+}
+
+type redactingLoggingTransport struct {
+	subsystem string
+	transport http.RoundTripper
+}
+
+func (t *redactingLoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.SubsystemSetField(req.Context(), t.subsystem, logging.FieldHttpTransactionId, transactionID())
+
+	fields := map[string]interface{}{
+		logging.FieldHttpOperationType:       logging.OperationHttpRequest,
+		logging.FieldHttpRequestMethod:       req.Method,
+		logging.FieldHttpRequestUri:          req.URL.RequestURI(),
+		logging.FieldHttpRequestProtoVersion: req.Proto,
+	}
+	addHeaderFields(fields, req.Header)
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		fields[logging.FieldHttpRequestBody] = RedactBody(req.URL.Path, body)
+	}
+	tflog.SubsystemDebug(ctx, t.subsystem, "Sending HTTP Request", fields)
+
+	res, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return res, err
+	}
+
+	fields = map[string]interface{}{
+		logging.FieldHttpOperationType:        logging.OperationHttpResponse,
+		logging.FieldHttpResponseProtoVersion: res.Proto,
+		logging.FieldHttpResponseStatusCode:   res.StatusCode,
+		logging.FieldHttpResponseStatusReason: res.Status,
+	}
+	addHeaderFields(fields, res.Header)
+	// the body of a watch is a stream that cannot be read ahead
+	if res.Body != nil && req.URL.Query().Get("watch") == "" {
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		res.Body = io.NopCloser(bytes.NewReader(body))
+		fields[logging.FieldHttpResponseBody] = RedactBody(req.URL.Path, body)
+	}
+	tflog.SubsystemDebug(ctx, t.subsystem, "Received HTTP Response", fields)
+
+	return res, nil
+}
+
+func transactionID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "Unable to assign Transaction ID: " + err.Error()
+	}
+	return hex.EncodeToString(b)
+}
+
+func addHeaderFields(fields map[string]interface{}, header http.Header) {
+	for k, v := range RedactHeader(header) {
+		if len(v) == 1 {
+			fields[k] = v[0]
+		} else {
+			fields[k] = v
+		}
+	}
+}
+
+// RedactHeader returns a copy of header with its credentials masked.
+func RedactHeader(header http.Header) http.Header {
+	h := header.Clone()
+	for _, k := range redactedHeaders {
+		for i, v := range h.Values(k) {
+			scheme, _, found := strings.Cut(v, " ")
+			if found && k != "Cookie" && k != "Set-Cookie" {
+				h[http.CanonicalHeaderKey(k)][i] = scheme + " " + RedactedValue
+			} else {
+				h[http.CanonicalHeaderKey(k)][i] = RedactedValue
+			}
+		}
+	}
+	return h
+}
+
+// RedactBody masks the sensitive content of a request or response body sent
+// to the API server at path: the values of the `data` and `stringData` of
+// Secrets along with their last-applied-configuration annotation, `status.token`
+// and bearer tokens. The keys are kept so that logs still show what changed.
+func RedactBody(path string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	secret := isSecretPath(path)
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		if secret {
+			// the body cannot be inspected, e.g. when it is not JSON
+			return RedactedValue
+		}
+		return bearerToken.ReplaceAllString(string(body), "${1}"+RedactedValue)
+	}
+	redactValue(v, secret)
+	out, err := json.Marshal(v)
+	if err != nil {
+		return RedactedValue
+	}
+	return bearerToken.ReplaceAllString(string(out), "${1}"+RedactedValue)
+}
+
+// isSecretPath reports whether an API path is about Secrets, e.g.
+// `/api/v1/namespaces/default/secrets/name`.
+func isSecretPath(path string) bool {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	for i, p := range parts {
+		// the resource name follows the version or the namespace name
+		if p == "secrets" && i > 0 && (parts[i-1] == "v1" || (i > 1 && parts[i-2] == "namespaces")) {
+			return true
+		}
+	}
+	return false
+}
+
+func redactValue(v interface{}, secret bool) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		redactObject(v, secret)
+	case []interface{}:
+		// JSON patches of a Secret
+		for _, op := range v {
+			m, ok := op.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			path, _ := m["path"].(string)
+			if _, ok := m["value"]; !ok || !secret {
+				continue
+			}
+			switch {
+			case strings.HasPrefix(path, "/data"), strings.HasPrefix(path, "/stringData"):
+				m["value"] = redactedData(m["value"])
+			case path == "/metadata/annotations/"+strings.ReplaceAll(lastAppliedAnnotation, "/", "~1"):
+				m["value"] = RedactedValue
+			case path == "/metadata/annotations":
+				redactLastApplied(m["value"])
+			}
+		}
+	}
+}
+
+func redactObject(m map[string]interface{}, secret bool) {
+	kind, _ := m["kind"].(string)
+	if kind != "" {
+		secret = kind == "Secret"
+	}
+	if secret {
+		for _, k := range []string{"data", "stringData"} {
+			if d, ok := m[k]; ok {
+				m[k] = redactedData(d)
+			}
+		}
+		if metadata, ok := m["metadata"].(map[string]interface{}); ok {
+			redactLastApplied(metadata["annotations"])
+		}
+	}
+	if status, ok := m["status"].(map[string]interface{}); ok {
+		if _, ok := status["token"]; ok {
+			status["token"] = RedactedValue
+		}
+	}
+	// lists, watch events and the objects of admission and apply responses
+	if items, ok := m["items"].([]interface{}); ok {
+		for _, item := range items {
+			if im, ok := item.(map[string]interface{}); ok {
+				redactObject(im, kind == "SecretList")
+			}
+		}
+	}
+	if obj, ok := m["object"].(map[string]interface{}); ok {
+		redactObject(obj, secret)
+	}
+}
+
+func redactedData(v interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return RedactedValue
+	}
+	out := make(map[string]interface{}, len(m))
+	for k := range m {
+		out[k] = RedactedValue
+	}
+	return out
+}
+
+// redactLastApplied masks the configuration applied by kubectl in the annotations of a Secret.
+func redactLastApplied(v interface{}) {
+	if annotations, ok := v.(map[string]interface{}); ok {
+		if _, ok := annotations[lastAppliedAnnotation]; ok {
+			annotations[lastAppliedAnnotation] = RedactedValue
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	cases := map[string]struct {
+		path     string
+		body     string
+		expected string
+	}{
+		"secret": {
+			path:     "/api/v1/namespaces/default/secrets",
+			body:     `{"kind":"Secret","metadata":{"name":"creds"},"data":{"password":"aHVudGVyMg=="},"stringData":{"user":"admin"}}`,
+			expected: `{"data":{"password":"***"},"kind":"Secret","metadata":{"name":"creds"},"stringData":{"user":"***"}}`,
+		},
+		"secret list": {
+			path:     "/api/v1/namespaces/default/secrets",
+			body:     `{"kind":"SecretList","items":[{"metadata":{"name":"a"},"data":{"k":"dg=="}}]}`,
+			expected: `{"items":[{"data":{"k":"***"},"metadata":{"name":"a"}}],"kind":"SecretList"}`,
+		},
+		"secret json patch": {
+			path:     "/api/v1/namespaces/default/secrets/creds",
+			body:     `[{"op":"replace","path":"/data/password","value":"dg=="},{"op":"add","path":"/metadata/labels/app","value":"web"}]`,
+			expected: `[{"op":"replace","path":"/data/password","value":"***"},{"op":"add","path":"/metadata/labels/app","value":"web"}]`,
+		},
+		"secret applied with kubectl": {
+			path:     "/api/v1/namespaces/default/secrets/creds",
+			body:     `{"kind":"Secret","metadata":{"name":"creds","annotations":{"kubectl.kubernetes.io/last-applied-configuration":"{\"stringData\":{\"user\":\"admin\"}}","team":"a"}},"data":{"user":"YWRtaW4="}}`,
+			expected: `{"data":{"user":"***"},"kind":"Secret","metadata":{"annotations":{"kubectl.kubernetes.io/last-applied-configuration":"***","team":"a"},"name":"creds"}}`,
+		},
+		"secret list applied with kubectl": {
+			path:     "/api/v1/secrets",
+			body:     `{"kind":"SecretList","items":[{"metadata":{"name":"a","annotations":{"kubectl.kubernetes.io/last-applied-configuration":"{}"}}}]}`,
+			expected: `{"items":[{"metadata":{"annotations":{"kubectl.kubernetes.io/last-applied-configuration":"***"},"name":"a"}}],"kind":"SecretList"}`,
+		},
+		"secret json patch of annotations": {
+			path:     "/api/v1/namespaces/default/secrets/creds",
+			body:     `[{"op":"replace","path":"/metadata/annotations/kubectl.kubernetes.io~1last-applied-configuration","value":"{}"},{"op":"add","path":"/metadata/annotations","value":{"kubectl.kubernetes.io/last-applied-configuration":"{}"}}]`,
+			expected: `[{"op":"replace","path":"/metadata/annotations/kubectl.kubernetes.io~1last-applied-configuration","value":"***"},{"op":"add","path":"/metadata/annotations","value":{"kubectl.kubernetes.io/last-applied-configuration":"***"}}]`,
+		},
+		"config map applied with kubectl": {
+			path:     "/api/v1/namespaces/default/configmaps/settings",
+			body:     `{"kind":"ConfigMap","metadata":{"annotations":{"kubectl.kubernetes.io/last-applied-configuration":"{}"}}}`,
+			expected: `{"kind":"ConfigMap","metadata":{"annotations":{"kubectl.kubernetes.io/last-applied-configuration":"{}"}}}`,
+		},
+		"secret merge patch": {
+			path:     "/api/v1/namespaces/default/secrets/creds",
+			body:     `{"data":{"password":"dg=="}}`,
+			expected: `{"data":{"password":"***"}}`,
+		},
+		"config map": {
+			path:     "/api/v1/namespaces/default/configmaps/settings",
+			body:     `{"kind":"ConfigMap","data":{"mode":"fast"}}`,
+			expected: `{"data":{"mode":"fast"},"kind":"ConfigMap"}`,
+		},
+		"token request": {
+			path:     "/api/v1/namespaces/default/serviceaccounts/app/token",
+			body:     `{"kind":"TokenRequest","status":{"token":"eyJhbGciOi","expirationTimestamp":"2030-01-01T00:00:00Z"}}`,
+			expected: `{"kind":"TokenRequest","status":{"expirationTimestamp":"2030-01-01T00:00:00Z","token":"***"}}`,
+		},
+		"bearer token": {
+			path:     "/api/v1/namespaces/default/configmaps/settings",
+			body:     `{"kind":"ConfigMap","data":{"curl":"curl -H 'Authorization: Bearer abc.def'"}}`,
+			expected: `{"data":{"curl":"curl -H 'Authorization: Bearer ***'"},"kind":"ConfigMap"}`,
+		},
+		"secret not json": {
+			path:     "/api/v1/namespaces/default/secrets/creds",
+			body:     "\x6b\x38\x73\x00",
+			expected: "***",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if out := RedactBody(tc.path, []byte(tc.body)); out != tc.expected {
+				t.Errorf("expected %s got %s", tc.expected, out)
+			}
+		})
+	}
+}
+
+func TestRedactHeader(t *testing.T) {
+	h := http.Header{}
+	h.Set("Authorization", "Bearer abc.def")
+	h.Set("Accept", "application/json")
+	out := RedactHeader(h)
+	if v := out.Get("Authorization"); v != "Bearer ***" {
+		t.Errorf("expected the Authorization header to be masked, got %q", v)
+	}
+	if v := out.Get("Accept"); v != "application/json" {
+		t.Errorf("expected the Accept header to be kept, got %q", v)
+	}
+	if v := h.Get("Authorization"); v != "Bearer abc.def" {
+		t.Errorf("expected the original header to be left as is, got %q", v)
+	}
+}
@@ -52,7 +52,7 @@ func TestParseResourceID(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.id, func(t *testing.T) {
-			gvk, n, ns, err := ParseResourceID(tc.id)
+			gvk, n, ns, err := ParseResourceID(tc.id, "default")
 			if err != nil && tc.err.Error() != err.Error() {
 				t.Errorf("expected error %q got %q", tc.err, err)
 			}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+)
+
+type warningCollectorKey struct{}
+
+// WarningCollector accumulates the warnings returned by the Kubernetes API server
+// in `Warning` response headers for the requests of a single operation.
+type WarningCollector struct {
+	mu       sync.Mutex
+	warnings []string
+	seen     map[string]bool
+}
+
+// ContextWithWarningCollector returns a copy of ctx carrying a new WarningCollector.
+// Requests made with the returned context through a transport wrapped by
+// WarningTransport record their API server warnings in the collector.
+func ContextWithWarningCollector(ctx context.Context) (context.Context, *WarningCollector) {
+	c := &WarningCollector{}
+	return context.WithValue(ctx, warningCollectorKey{}, c), c
+}
+
+// WarningCollectorFromContext returns the WarningCollector carried by ctx, if any.
+func WarningCollectorFromContext(ctx context.Context) *WarningCollector {
+	c, _ := ctx.Value(warningCollectorKey{}).(*WarningCollector)
+	return c
+}
+
+// Add records a warning unless it was already recorded by this collector.
+func (c *WarningCollector) Add(text string) {
+	if text == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.seen[text] {
+		return
+	}
+	if c.seen == nil {
+		c.seen = map[string]bool{}
+	}
+	c.seen[text] = true
+	c.warnings = append(c.warnings, text)
+}
+
+// Warnings returns the warnings recorded so far, in the order they were received.
+func (c *WarningCollector) Warnings() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.warnings...)
+}
+
+// WarningTransport wraps rt so that the RFC2616 `Warning` headers returned by the
+// API server are recorded in the WarningCollector of the request context.
+// It is meant to be used as (or chained into) rest.Config.WrapTransport.
+func WarningTransport(rt http.RoundTripper) http.RoundTripper {
+	return &warningRoundTripper{rt: rt}
+}
+
+type warningRoundTripper struct {
+	rt http.RoundTripper
+}
+
+func (t *warningRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.rt.RoundTrip(req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	c := WarningCollectorFromContext(req.Context())
+	if c == nil {
+		return resp, err
+	}
+	warnings, _ := utilnet.ParseWarningHeaders(resp.Header["Warning"])
+	for _, w := range warnings {
+		// only 299 "miscellaneous persistent warnings" are emitted by the API server
+		if w.Code == 299 {
+			c.Add(w.Text)
+		}
+	}
+	return resp, err
+}
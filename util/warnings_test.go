@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+type headerRoundTripper http.Header
+
+func (h headerRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK, Header: http.Header(h), Request: req}, nil
+}
+
+func TestWarningTransport(t *testing.T) {
+	rt := WarningTransport(headerRoundTripper{
+		"Warning": []string{
+			`299 - "policy/v1beta1 PodSecurityPolicy is deprecated in v1.21+, unavailable in v1.25+"`,
+			`299 - "would violate PodSecurity \"restricted:latest\": allowPrivilegeEscalation != false"`,
+			`199 - "not an API server warning"`,
+		},
+	})
+
+	do := func(ctx context.Context) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.com/api", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := rt.RoundTrip(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// requests without a collector are passed through untouched
+	do(context.Background())
+
+	ctx, c := ContextWithWarningCollector(context.Background())
+	do(ctx)
+	do(ctx)
+	expected := []string{
+		"policy/v1beta1 PodSecurityPolicy is deprecated in v1.21+, unavailable in v1.25+",
+		`would violate PodSecurity "restricted:latest": allowPrivilegeEscalation != false`,
+	}
+	if w := c.Warnings(); !reflect.DeepEqual(expected, w) {
+		t.Errorf("expected %#v got %#v", expected, w)
+	}
+
+	// each operation reports the warnings of its own requests
+	ctx, c = ContextWithWarningCollector(context.Background())
+	do(ctx)
+	if w := c.Warnings(); !reflect.DeepEqual(expected, w) {
+		t.Errorf("expected %#v got %#v", expected, w)
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// FailureWarningsLimit and FailureContainersLimit bound the number of
+	// warnings and failing containers described when a workload fails.
+	FailureWarningsLimit   = 5
+	FailureContainersLimit = 3
+	// FailureLogLines is the number of log lines shown for a failing container.
+	FailureLogLines = 10
+)
+
+// ContainerFailure describes a container that fails to start or to run.
+type ContainerFailure struct {
+	Pod       string
+	Container string
+	Reason    string
+	Message   string
+	// HasLogs reports whether the container ran, and Previous whether its
+	// logs are those of the instance before the last restart.
+	HasLogs  bool
+	Previous bool
+}
+
+// WorkloadPods returns the pods a workload of the given kind controls, through
+// its ReplicaSets for a Deployment, along with the UIDs of the workload and of
+// the ReplicaSets walked.
+func WorkloadPods(ctx context.Context, conn kubernetes.Interface, namespace string, uid types.UID, kind string, selector *metav1.LabelSelector) ([]corev1.Pod, map[types.UID]bool, error) {
+	owners := map[types.UID]bool{uid: true}
+	if selector == nil {
+		return nil, owners, nil
+	}
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, owners, err
+	}
+	opts := metav1.ListOptions{LabelSelector: s.String()}
+
+	if kind == "Deployment" {
+		rss, err := conn.AppsV1().ReplicaSets(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, owners, err
+		}
+		for i := range rss.Items {
+			if c := metav1.GetControllerOf(&rss.Items[i]); c != nil && c.UID == uid {
+				owners[rss.Items[i].UID] = true
+			}
+		}
+	}
+
+	list, err := conn.CoreV1().Pods(namespace).List(ctx, opts)
+	if err != nil {
+		return nil, owners, err
+	}
+	var pods []corev1.Pod
+	for _, p := range list.Items {
+		if c := metav1.GetControllerOf(&p); c != nil && owners[c.UID] {
+			pods = append(pods, p)
+		}
+	}
+	return pods, owners, nil
+}
+
+// ContainerFailures returns the failing containers of pod: the containers
+// waiting for a reason other than starting, like ImagePullBackOff or
+// CrashLoopBackOff, and the containers that terminated in error, like OOMKilled.
+func ContainerFailures(pod corev1.Pod) []ContainerFailure {
+	var out []ContainerFailure
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, s := range statuses {
+		f := ContainerFailure{Pod: pod.Name, Container: s.Name}
+		switch {
+		case s.State.Waiting != nil:
+			switch s.State.Waiting.Reason {
+			case "", "ContainerCreating", "PodInitializing":
+				continue
+			}
+			f.Reason = s.State.Waiting.Reason
+			f.Message = s.State.Waiting.Message
+			if t := s.LastTerminationState.Terminated; t != nil {
+				f.Message = strings.TrimSpace(fmt.Sprintf("%s (last terminated: %s, exit code %d)", f.Message, t.Reason, t.ExitCode))
+				f.HasLogs = true
+				f.Previous = true
+			}
+		case s.State.Terminated != nil && s.State.Terminated.ExitCode != 0:
+			t := s.State.Terminated
+			f.Reason = t.Reason
+			f.Message = strings.TrimSpace(fmt.Sprintf("exit code %d %s", t.ExitCode, t.Message))
+			f.HasLogs = true
+		default:
+			continue
+		}
+		out = append(out, f)
+	}
+	return out
+}
+
+// DescribeContainerFailures returns a summary of up to limit failing containers
+// of pods, with the last tailLines lines of the logs of the ones that ran.
+// Containers failing the same way in several pods are only described once.
+func DescribeContainerFailures(ctx context.Context, conn kubernetes.Interface, pods []corev1.Pod, limit int, tailLines int64) string {
+	var b strings.Builder
+	seen := map[string]bool{}
+	count := 0
+	for _, p := range pods {
+		for _, f := range ContainerFailures(p) {
+			if count >= limit {
+				return b.String()
+			}
+			key := f.Container + "/" + f.Reason
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			count++
+
+			fmt.Fprintf(&b, "\n   * %s (container %s): %s", f.Pod, f.Container, f.Reason)
+			if f.Message != "" {
+				fmt.Fprintf(&b, ": %s", f.Message)
+			}
+			if !f.HasLogs || tailLines <= 0 {
+				continue
+			}
+			logs, err := conn.CoreV1().Pods(p.Namespace).GetLogs(p.Name, &corev1.PodLogOptions{
+				Container: f.Container,
+				Previous:  f.Previous,
+				TailLines: &tailLines,
+			}).DoRaw(ctx)
+			if err != nil {
+				log.Printf("[DEBUG] Could not get the logs of container %s of pod %s: %s", f.Container, f.Pod, err)
+				continue
+			}
+			if lines := strings.TrimRight(string(logs), "\n"); lines != "" {
+				fmt.Fprintf(&b, "\n     Last log lines:\n       %s", strings.ReplaceAll(lines, "\n", "\n       "))
+			}
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func controlledBy(uid types.UID) []metav1.OwnerReference {
+	return []metav1.OwnerReference{{Name: "owner", UID: uid, Controller: ptr.To(true)}}
+}
+
+func TestContainerFailures(t *testing.T) {
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-1"},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:  "init",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"}},
+				},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:  "starting",
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}},
+				},
+				{
+					Name:  "pull",
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "Back-off pulling image"}},
+				},
+				{
+					Name:                 "crash",
+					State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+					LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}},
+				},
+				{
+					Name:  "failed",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}},
+				},
+				{
+					Name:  "running",
+					State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+				},
+			},
+		},
+	}
+
+	expected := []ContainerFailure{
+		{Pod: "app-1", Container: "pull", Reason: "ImagePullBackOff", Message: "Back-off pulling image"},
+		{Pod: "app-1", Container: "crash", Reason: "CrashLoopBackOff", Message: "(last terminated: OOMKilled, exit code 137)", HasLogs: true, Previous: true},
+		{Pod: "app-1", Container: "failed", Reason: "Error", Message: "exit code 1", HasLogs: true},
+	}
+	if got := ContainerFailures(pod); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, got)
+	}
+}
+
+func TestWorkloadPods(t *testing.T) {
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+	labels := map[string]string{"app": "web"}
+	conn := fake.NewSimpleClientset(
+		&appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default", UID: "rs-1", Labels: labels, OwnerReferences: controlledBy("deployment")}},
+		&appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: "other-1", Namespace: "default", UID: "rs-2", Labels: labels, OwnerReferences: controlledBy("other")}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1-a", Namespace: "default", Labels: labels, OwnerReferences: controlledBy("rs-1")}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other-1-a", Namespace: "default", Labels: labels, OwnerReferences: controlledBy("rs-2")}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "unlabeled", Namespace: "default", OwnerReferences: controlledBy("rs-1")}},
+	)
+
+	pods, owners, err := WorkloadPods(context.Background(), conn, "default", "deployment", "Deployment", selector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pods) != 1 || pods[0].Name != "web-1-a" {
+		t.Fatalf("expected only pod web-1-a, got %v", pods)
+	}
+	if !reflect.DeepEqual(owners, map[types.UID]bool{"deployment": true, "rs-1": true}) {
+		t.Fatalf("unexpected owners %v", owners)
+	}
+}
+
+func TestDescribeContainerFailures(t *testing.T) {
+	crashing := func(name string) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:                 "app",
+					State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+					LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}},
+				}},
+			},
+		}
+	}
+	conn := fake.NewSimpleClientset()
+
+	out := DescribeContainerFailures(context.Background(), conn, []corev1.Pod{crashing("app-1"), crashing("app-2")}, 3, 10)
+	if !strings.Contains(out, "app-1 (container app): CrashLoopBackOff: (last terminated: Error, exit code 1)") {
+		t.Fatalf("expected the failure of app-1 to be described, got %q", out)
+	}
+	if strings.Contains(out, "app-2") {
+		t.Fatalf("expected the same failure to be described once, got %q", out)
+	}
+	if !strings.Contains(out, "Last log lines:") {
+		t.Fatalf("expected the logs of the container, got %q", out)
+	}
+}